@@ -23,9 +23,11 @@ import (
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/logging"
@@ -34,15 +36,52 @@ import (
 
 const (
 	FlagsConfigName = "config-kafka-features"
+
+	// DispatcherRateLimiterFlag is the name of the flag controlling the dispatcher rate limiter.
+	DispatcherRateLimiterFlag = "dispatcher.rate-limiter"
+	// DispatcherOrderedExecutorMetricsFlag is the name of the flag controlling the dispatcher ordered executor metrics.
+	DispatcherOrderedExecutorMetricsFlag = "dispatcher.ordered-executor-metrics"
+)
+
+// DuplicateGroupPolicy is the behavior of the controller when Consumers bound to the same pod share
+// a consumer group.
+type DuplicateGroupPolicy string
+
+const (
+	// DuplicateGroupPolicyReject fails the binding of the Consumer sharing the consumer group.
+	DuplicateGroupPolicyReject DuplicateGroupPolicy = "reject"
+	// DuplicateGroupPolicyWarn binds the Consumer sharing the consumer group with a warning condition.
+	DuplicateGroupPolicyWarn DuplicateGroupPolicy = "warn"
+	// DuplicateGroupPolicyAllow binds the Consumer sharing the consumer group.
+	DuplicateGroupPolicyAllow DuplicateGroupPolicy = "allow"
 )
 
 type features struct {
-	DispatcherRateLimiter            feature.Flag
-	DispatcherOrderedExecutorMetrics feature.Flag
-	ControllerAutoscaler             feature.Flag
-	TriggersConsumerGroupTemplate    template.Template
-	BrokersTopicTemplate             template.Template
-	ChannelsTopicTemplate            template.Template
+	DispatcherRateLimiter             feature.Flag
+	DispatcherOrderedExecutorMetrics  feature.Flag
+	ControllerAutoscaler              feature.Flag
+	ControllerPartitionExpansion      feature.Flag
+	ControllerSubscriberOrdering      feature.Flag
+	ControllerClientRackFromZone      feature.Flag
+	ControllerStableEgressUID         feature.Flag
+	ControllerOversubscription        feature.Flag
+	ControllerEffectiveConfig         feature.Flag
+	ControllerCompactionCheck         feature.Flag
+	ControllerOverProvisioningCheck   feature.Flag
+	DispatcherReconnectBackoff        time.Duration
+	DispatcherReconnectBackoffMax     time.Duration
+	DispatcherCommitInterval          time.Duration
+	DispatcherFetchMaxWait            time.Duration
+	DispatcherKeepAlive               time.Duration
+	ControllerMaxRetryDuration        time.Duration
+	ControllerNamespaceConsumerQuota  int
+	ControllerNamespaceEgressQuota    int
+	ControllerDuplicateGroupPolicy    DuplicateGroupPolicy
+	ControllerSubscriberHostAllowlist sets.Set[string]
+	ControllerReplySchemeAllowlist    sets.Set[string]
+	TriggersConsumerGroupTemplate     template.Template
+	BrokersTopicTemplate              template.Template
+	ChannelsTopicTemplate             template.Template
 }
 
 type KafkaFeatureFlags struct {
@@ -50,7 +89,26 @@ type KafkaFeatureFlags struct {
 	m        sync.RWMutex
 }
 
+const (
+	// defaultDispatcherReconnectBackoff and defaultDispatcherReconnectBackoffMax match the Kafka client
+	// defaults of `reconnect.backoff.ms` and `reconnect.backoff.max.ms`.
+	defaultDispatcherReconnectBackoff    = 50 * time.Millisecond
+	defaultDispatcherReconnectBackoffMax = time.Second
+	// defaultDispatcherCommitInterval matches the Kafka client default of `auto.commit.interval.ms`.
+	defaultDispatcherCommitInterval = 5 * time.Second
+	// defaultDispatcherFetchMaxWait matches the Kafka client default of `fetch.max.wait.ms`.
+	defaultDispatcherFetchMaxWait = 500 * time.Millisecond
+	// defaultDispatcherKeepAlive matches the idle connection timeout of the dispatcher HTTP client.
+	defaultDispatcherKeepAlive = 60 * time.Second
+	// defaultControllerMaxRetryDuration matches the Kafka default of `log.retention.hours`, retrying
+	// for longer risks the events being deleted from the topic before they're delivered.
+	defaultControllerMaxRetryDuration = 7 * 24 * time.Hour
+)
+
 var (
+	// defaultControllerReplySchemeAllowlist are the schemes allowed for the reply URLs when the allowlist isn't set.
+	defaultControllerReplySchemeAllowlist = []string{"http", "https"}
+
 	defaultTriggersConsumerGroupTemplate *template.Template
 	defaultBrokersTopicTemplate          *template.Template
 	defaultChannelsTopicTemplate         *template.Template
@@ -66,12 +124,31 @@ func init() {
 func DefaultFeaturesConfig() *KafkaFeatureFlags {
 	return &KafkaFeatureFlags{
 		features: features{
-			DispatcherRateLimiter:            feature.Disabled,
-			DispatcherOrderedExecutorMetrics: feature.Disabled,
-			ControllerAutoscaler:             feature.Disabled,
-			TriggersConsumerGroupTemplate:    *defaultTriggersConsumerGroupTemplate,
-			BrokersTopicTemplate:             *defaultBrokersTopicTemplate,
-			ChannelsTopicTemplate:            *defaultChannelsTopicTemplate,
+			DispatcherRateLimiter:             feature.Disabled,
+			DispatcherOrderedExecutorMetrics:  feature.Disabled,
+			ControllerAutoscaler:              feature.Disabled,
+			ControllerPartitionExpansion:      feature.Disabled,
+			ControllerSubscriberOrdering:      feature.Disabled,
+			ControllerClientRackFromZone:      feature.Disabled,
+			ControllerStableEgressUID:         feature.Disabled,
+			ControllerOversubscription:        feature.Disabled,
+			ControllerEffectiveConfig:         feature.Disabled,
+			ControllerCompactionCheck:         feature.Disabled,
+			ControllerOverProvisioningCheck:   feature.Disabled,
+			DispatcherReconnectBackoff:        defaultDispatcherReconnectBackoff,
+			DispatcherReconnectBackoffMax:     defaultDispatcherReconnectBackoffMax,
+			DispatcherCommitInterval:          defaultDispatcherCommitInterval,
+			DispatcherFetchMaxWait:            defaultDispatcherFetchMaxWait,
+			DispatcherKeepAlive:               defaultDispatcherKeepAlive,
+			ControllerMaxRetryDuration:        defaultControllerMaxRetryDuration,
+			ControllerNamespaceConsumerQuota:  0,
+			ControllerNamespaceEgressQuota:    0,
+			ControllerDuplicateGroupPolicy:    DuplicateGroupPolicyWarn,
+			ControllerSubscriberHostAllowlist: sets.New[string](),
+			ControllerReplySchemeAllowlist:    sets.New(defaultControllerReplySchemeAllowlist...),
+			TriggersConsumerGroupTemplate:     *defaultTriggersConsumerGroupTemplate,
+			BrokersTopicTemplate:              *defaultBrokersTopicTemplate,
+			ChannelsTopicTemplate:             *defaultChannelsTopicTemplate,
 		},
 	}
 }
@@ -80,12 +157,50 @@ func DefaultFeaturesConfig() *KafkaFeatureFlags {
 func NewFeaturesConfigFromMap(cm *corev1.ConfigMap) (*KafkaFeatureFlags, error) {
 	nc := DefaultFeaturesConfig()
 	err := configmap.Parse(cm.Data,
-		asFlag("dispatcher.rate-limiter", &nc.features.DispatcherRateLimiter),
+		asFlag(DispatcherRateLimiterFlag, &nc.features.DispatcherRateLimiter),
 		asFlag("dispatcher-rate-limiter", &nc.features.DispatcherRateLimiter),
-		asFlag("dispatcher.ordered-executor-metrics", &nc.features.DispatcherOrderedExecutorMetrics),
+		asFlag(DispatcherOrderedExecutorMetricsFlag, &nc.features.DispatcherOrderedExecutorMetrics),
 		asFlag("dispatcher-ordered-executor-metrics", &nc.features.DispatcherOrderedExecutorMetrics),
 		asFlag("controller.autoscaler", &nc.features.ControllerAutoscaler),
 		asFlag("controller-autoscaler-keda", &nc.features.ControllerAutoscaler),
+		asFlag("controller.partition-expansion", &nc.features.ControllerPartitionExpansion),
+		asFlag("controller-partition-expansion", &nc.features.ControllerPartitionExpansion),
+		asFlag("controller.subscriber-ordering-check", &nc.features.ControllerSubscriberOrdering),
+		asFlag("controller-subscriber-ordering-check", &nc.features.ControllerSubscriberOrdering),
+		asFlag("controller.client-rack-from-zone", &nc.features.ControllerClientRackFromZone),
+		asFlag("controller-client-rack-from-zone", &nc.features.ControllerClientRackFromZone),
+		asFlag("controller.stable-egress-uid", &nc.features.ControllerStableEgressUID),
+		asFlag("controller-stable-egress-uid", &nc.features.ControllerStableEgressUID),
+		asFlag("controller.vreplicas-oversubscription-check", &nc.features.ControllerOversubscription),
+		asFlag("controller-vreplicas-oversubscription-check", &nc.features.ControllerOversubscription),
+		asFlag("controller.effective-config-configmap", &nc.features.ControllerEffectiveConfig),
+		asFlag("controller-effective-config-configmap", &nc.features.ControllerEffectiveConfig),
+		asFlag("controller.topic-compaction-check", &nc.features.ControllerCompactionCheck),
+		asFlag("controller-topic-compaction-check", &nc.features.ControllerCompactionCheck),
+		asFlag("controller.overprovisioned-replicas-check", &nc.features.ControllerOverProvisioningCheck),
+		asFlag("controller-overprovisioned-replicas-check", &nc.features.ControllerOverProvisioningCheck),
+		configmap.AsDuration("dispatcher.reconnect-backoff", &nc.features.DispatcherReconnectBackoff),
+		configmap.AsDuration("dispatcher-reconnect-backoff", &nc.features.DispatcherReconnectBackoff),
+		configmap.AsDuration("dispatcher.reconnect-backoff-max", &nc.features.DispatcherReconnectBackoffMax),
+		configmap.AsDuration("dispatcher-reconnect-backoff-max", &nc.features.DispatcherReconnectBackoffMax),
+		configmap.AsDuration("dispatcher.commit-interval", &nc.features.DispatcherCommitInterval),
+		configmap.AsDuration("dispatcher-commit-interval", &nc.features.DispatcherCommitInterval),
+		configmap.AsDuration("dispatcher.fetch-max-wait", &nc.features.DispatcherFetchMaxWait),
+		configmap.AsDuration("dispatcher-fetch-max-wait", &nc.features.DispatcherFetchMaxWait),
+		configmap.AsDuration("dispatcher.keep-alive", &nc.features.DispatcherKeepAlive),
+		configmap.AsDuration("dispatcher-keep-alive", &nc.features.DispatcherKeepAlive),
+		configmap.AsDuration("controller.max-retry-duration", &nc.features.ControllerMaxRetryDuration),
+		configmap.AsDuration("controller-max-retry-duration", &nc.features.ControllerMaxRetryDuration),
+		configmap.AsInt("controller.namespace-consumer-quota", &nc.features.ControllerNamespaceConsumerQuota),
+		configmap.AsInt("controller-namespace-consumer-quota", &nc.features.ControllerNamespaceConsumerQuota),
+		configmap.AsInt("controller.namespace-egress-quota", &nc.features.ControllerNamespaceEgressQuota),
+		configmap.AsInt("controller-namespace-egress-quota", &nc.features.ControllerNamespaceEgressQuota),
+		asDuplicateGroupPolicy("controller.duplicate-group-policy", &nc.features.ControllerDuplicateGroupPolicy),
+		asDuplicateGroupPolicy("controller-duplicate-group-policy", &nc.features.ControllerDuplicateGroupPolicy),
+		configmap.AsStringSet("controller.subscriber-host-allowlist", &nc.features.ControllerSubscriberHostAllowlist),
+		configmap.AsStringSet("controller-subscriber-host-allowlist", &nc.features.ControllerSubscriberHostAllowlist),
+		configmap.AsStringSet("controller.reply-scheme-allowlist", &nc.features.ControllerReplySchemeAllowlist),
+		configmap.AsStringSet("controller-reply-scheme-allowlist", &nc.features.ControllerReplySchemeAllowlist),
 		asTemplate("triggers.consumergroup.template", &nc.features.TriggersConsumerGroupTemplate),
 		asTemplate("triggers-consumergroup-template", &nc.features.TriggersConsumerGroupTemplate),
 		asTemplate("brokers.topic.template", &nc.features.BrokersTopicTemplate),
@@ -93,6 +208,26 @@ func NewFeaturesConfigFromMap(cm *corev1.ConfigMap) (*KafkaFeatureFlags, error)
 		asTemplate("channels.topic.template", &nc.features.ChannelsTopicTemplate),
 		asTemplate("channels-topic-template", &nc.features.ChannelsTopicTemplate),
 	)
+	// Hostnames are case-insensitive and an empty value leaves the allowlist empty, permitting all hosts.
+	allowlist := sets.New[string]()
+	for h := range nc.features.ControllerSubscriberHostAllowlist {
+		if h != "" {
+			allowlist.Insert(strings.ToLower(h))
+		}
+	}
+	nc.features.ControllerSubscriberHostAllowlist = allowlist
+	// Schemes are case-insensitive and an empty value restores the default allowlist, since allowing
+	// every scheme would allow the reply URLs that the dispatcher can't, or shouldn't, send events to.
+	schemes := sets.New[string]()
+	for s := range nc.features.ControllerReplySchemeAllowlist {
+		if s != "" {
+			schemes.Insert(strings.ToLower(s))
+		}
+	}
+	if schemes.Len() == 0 {
+		schemes.Insert(defaultControllerReplySchemeAllowlist...)
+	}
+	nc.features.ControllerReplySchemeAllowlist = schemes
 	return nc, err
 }
 
@@ -114,6 +249,102 @@ func (f *KafkaFeatureFlags) IsControllerAutoscalerEnabled() bool {
 	return f.features.ControllerAutoscaler == feature.Enabled
 }
 
+func (f *KafkaFeatureFlags) IsControllerPartitionExpansionEnabled() bool {
+	return f.features.ControllerPartitionExpansion == feature.Enabled
+}
+
+func (f *KafkaFeatureFlags) IsControllerSubscriberOrderingCheckEnabled() bool {
+	return f.features.ControllerSubscriberOrdering == feature.Enabled
+}
+
+func (f *KafkaFeatureFlags) IsControllerClientRackFromZoneEnabled() bool {
+	return f.features.ControllerClientRackFromZone == feature.Enabled
+}
+
+func (f *KafkaFeatureFlags) IsControllerStableEgressUIDEnabled() bool {
+	return f.features.ControllerStableEgressUID == feature.Enabled
+}
+
+func (f *KafkaFeatureFlags) IsControllerVReplicasOversubscriptionCheckEnabled() bool {
+	return f.features.ControllerOversubscription == feature.Enabled
+}
+
+func (f *KafkaFeatureFlags) IsControllerEffectiveConfigEnabled() bool {
+	return f.features.ControllerEffectiveConfig == feature.Enabled
+}
+
+func (f *KafkaFeatureFlags) IsControllerTopicCompactionCheckEnabled() bool {
+	return f.features.ControllerCompactionCheck == feature.Enabled
+}
+
+func (f *KafkaFeatureFlags) IsControllerOverProvisionedReplicasCheckEnabled() bool {
+	return f.features.ControllerOverProvisioningCheck == feature.Enabled
+}
+
+// DispatcherReconnectBackoff is the default backoff before reconnecting to a Kafka broker.
+func (f *KafkaFeatureFlags) DispatcherReconnectBackoff() time.Duration {
+	return f.features.DispatcherReconnectBackoff
+}
+
+// DispatcherReconnectBackoffMax is the default maximum backoff before reconnecting to a Kafka broker.
+func (f *KafkaFeatureFlags) DispatcherReconnectBackoffMax() time.Duration {
+	return f.features.DispatcherReconnectBackoffMax
+}
+
+// DispatcherCommitInterval is the default interval between periodic offset commits.
+func (f *KafkaFeatureFlags) DispatcherCommitInterval() time.Duration {
+	return f.features.DispatcherCommitInterval
+}
+
+// DispatcherFetchMaxWait is the default maximum time a fetch request waits for data on the Kafka broker.
+func (f *KafkaFeatureFlags) DispatcherFetchMaxWait() time.Duration {
+	return f.features.DispatcherFetchMaxWait
+}
+
+// DispatcherKeepAlive is the default time an idle connection to the subscriber is kept open for reuse.
+func (f *KafkaFeatureFlags) DispatcherKeepAlive() time.Duration {
+	return f.features.DispatcherKeepAlive
+}
+
+// ControllerMaxRetryDuration is the maximum total backoff across the retries of an event.
+func (f *KafkaFeatureFlags) ControllerMaxRetryDuration() time.Duration {
+	return f.features.ControllerMaxRetryDuration
+}
+
+// ControllerNamespaceConsumerQuota is the maximum number of Consumers in a namespace, a value lower
+// than or equal to 0 means unlimited.
+func (f *KafkaFeatureFlags) ControllerNamespaceConsumerQuota() int {
+	return f.features.ControllerNamespaceConsumerQuota
+}
+
+// ControllerNamespaceEgressQuota is the maximum number of egresses of the Consumers in a namespace, a
+// value lower than or equal to 0 means unlimited.
+func (f *KafkaFeatureFlags) ControllerNamespaceEgressQuota() int {
+	return f.features.ControllerNamespaceEgressQuota
+}
+
+// DuplicateGroupPolicy is the behavior when Consumers bound to the same pod share a consumer group.
+func (f *KafkaFeatureFlags) DuplicateGroupPolicy() DuplicateGroupPolicy {
+	return f.features.ControllerDuplicateGroupPolicy
+}
+
+// IsSubscriberHostAllowed returns whether Consumers may deliver events to the given subscriber host,
+// all hosts are allowed when the allowlist is empty.
+func (f *KafkaFeatureFlags) IsSubscriberHostAllowed(host string) bool {
+	return f.features.ControllerSubscriberHostAllowlist.Len() == 0 ||
+		f.features.ControllerSubscriberHostAllowlist.Has(strings.ToLower(host))
+}
+
+// IsReplySchemeAllowed returns whether Consumers may send the replies to a URL with the given scheme.
+func (f *KafkaFeatureFlags) IsReplySchemeAllowed(scheme string) bool {
+	return f.features.ControllerReplySchemeAllowlist.Has(strings.ToLower(scheme))
+}
+
+// ReplySchemeAllowlist returns the sorted schemes Consumers may send the replies to.
+func (f *KafkaFeatureFlags) ReplySchemeAllowlist() []string {
+	return sets.List(f.features.ControllerReplySchemeAllowlist)
+}
+
 func (f *KafkaFeatureFlags) ExecuteTriggersConsumerGroupTemplate(triggerMetadata v1.ObjectMeta) (string, error) {
 	return executeTemplateToString(f.features.TriggersConsumerGroupTemplate, triggerMetadata, "unable to execute triggers consumergroup template: %w")
 }
@@ -194,6 +425,22 @@ func asFlag(key string, target *feature.Flag) configmap.ParseFunc {
 	}
 }
 
+// asDuplicateGroupPolicy parses the value at key as a DuplicateGroupPolicy into the target, if it exists.
+func asDuplicateGroupPolicy(key string, target *DuplicateGroupPolicy) configmap.ParseFunc {
+	return func(data map[string]string) error {
+		if raw, ok := data[key]; ok {
+			for _, policy := range []DuplicateGroupPolicy{DuplicateGroupPolicyReject, DuplicateGroupPolicyWarn, DuplicateGroupPolicyAllow} {
+				if strings.EqualFold(raw, string(policy)) {
+					*target = policy
+					return nil
+				}
+			}
+			return fmt.Errorf("invalid %s %q, expected one of reject, warn or allow", key, raw)
+		}
+		return nil
+	}
+}
+
 // asTemplate parses the value at key as a go text template into the target, if it exists.
 func asTemplate(key string, target *template.Template) configmap.ParseFunc {
 	return func(data map[string]string) error {
@@ -20,8 +20,10 @@ import (
 	"context"
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/eventing/pkg/apis/feature"
 	cm "knative.dev/pkg/configmap/testing"
@@ -33,6 +35,14 @@ func TestFlags_IsDispatcherRateLimiterEnabled(t *testing.T) {
 	require.False(t, nc.features.DispatcherRateLimiter == feature.Enabled)
 	require.False(t, nc.features.DispatcherOrderedExecutorMetrics == feature.Enabled)
 	require.False(t, nc.features.ControllerAutoscaler == feature.Enabled)
+	require.False(t, nc.features.ControllerPartitionExpansion == feature.Enabled)
+	require.False(t, nc.features.ControllerSubscriberOrdering == feature.Enabled)
+	require.False(t, nc.features.ControllerOversubscription == feature.Enabled)
+	require.False(t, nc.features.ControllerEffectiveConfig == feature.Enabled)
+	require.False(t, nc.features.ControllerCompactionCheck == feature.Enabled)
+	require.False(t, nc.features.ControllerOverProvisioningCheck == feature.Enabled)
+	require.False(t, nc.features.ControllerStableEgressUID == feature.Enabled)
+	require.False(t, nc.features.ControllerClientRackFromZone == feature.Enabled)
 }
 
 func TestFlags_IsEnabled_ContainingFlag(t *testing.T) {
@@ -42,11 +52,21 @@ func TestFlags_IsEnabled_ContainingFlag(t *testing.T) {
 			DispatcherRateLimiter:            feature.Enabled,
 			DispatcherOrderedExecutorMetrics: feature.Enabled,
 			ControllerAutoscaler:             feature.Enabled,
+			ControllerPartitionExpansion:     feature.Enabled,
+			ControllerSubscriberOrdering:     feature.Enabled,
+			ControllerOversubscription:       feature.Enabled,
+			ControllerStableEgressUID:        feature.Enabled,
+			ControllerClientRackFromZone:     feature.Enabled,
 		},
 	})
 	require.True(t, nc.features.DispatcherRateLimiter == feature.Enabled)
 	require.True(t, nc.features.DispatcherOrderedExecutorMetrics == feature.Enabled)
 	require.True(t, nc.features.ControllerAutoscaler == feature.Enabled)
+	require.True(t, nc.features.ControllerPartitionExpansion == feature.Enabled)
+	require.True(t, nc.features.ControllerSubscriberOrdering == feature.Enabled)
+	require.True(t, nc.features.ControllerOversubscription == feature.Enabled)
+	require.True(t, nc.features.ControllerStableEgressUID == feature.Enabled)
+	require.True(t, nc.features.ControllerClientRackFromZone == feature.Enabled)
 }
 
 func TestGetFlags(t *testing.T) {
@@ -58,6 +78,29 @@ func TestGetFlags(t *testing.T) {
 	require.True(t, flags.IsDispatcherOrderedExecutorMetricsEnabled())
 	require.True(t, flags.IsControllerAutoscalerEnabled())
 	require.True(t, flags.IsControllerAutoscalerEnabled())
+	require.True(t, flags.IsControllerPartitionExpansionEnabled())
+	require.True(t, flags.IsControllerSubscriberOrderingCheckEnabled())
+	require.True(t, flags.IsControllerVReplicasOversubscriptionCheckEnabled())
+	require.True(t, flags.IsControllerEffectiveConfigEnabled())
+	require.True(t, flags.IsControllerTopicCompactionCheckEnabled())
+	require.True(t, flags.IsControllerOverProvisionedReplicasCheckEnabled())
+	require.True(t, flags.IsControllerStableEgressUIDEnabled())
+	require.True(t, flags.IsControllerClientRackFromZoneEnabled())
+	require.Equal(t, 100*time.Millisecond, flags.DispatcherReconnectBackoff())
+	require.Equal(t, 10*time.Second, flags.DispatcherReconnectBackoffMax())
+	require.Equal(t, time.Second, flags.DispatcherCommitInterval())
+	require.Equal(t, 100*time.Millisecond, flags.DispatcherFetchMaxWait())
+	require.Equal(t, 30*time.Second, flags.DispatcherKeepAlive())
+	require.Equal(t, 24*time.Hour, flags.ControllerMaxRetryDuration())
+	require.Equal(t, 10, flags.ControllerNamespaceConsumerQuota())
+	require.Equal(t, 20, flags.ControllerNamespaceEgressQuota())
+	require.Equal(t, DuplicateGroupPolicyReject, flags.DuplicateGroupPolicy())
+	require.True(t, flags.IsSubscriberHostAllowed("sink.example.com"))
+	require.True(t, flags.IsSubscriberHostAllowed("Other.Example.com"))
+	require.False(t, flags.IsSubscriberHostAllowed("evil.example.com"))
+	require.True(t, flags.IsReplySchemeAllowed("HTTPS"))
+	require.False(t, flags.IsReplySchemeAllowed("http"))
+	require.Equal(t, []string{"https"}, flags.ReplySchemeAllowlist())
 	require.Len(t, flags.features.TriggersConsumerGroupTemplate.Root.Nodes, 4)
 	require.Equal(t, flags.features.TriggersConsumerGroupTemplate.Name(), "triggers.consumergroup.template")
 	require.Len(t, flags.features.BrokersTopicTemplate.Root.Nodes, 4)
@@ -79,6 +122,23 @@ func TestStoreLoadWithConfigMap(t *testing.T) {
 	require.Equal(t, expected.IsDispatcherRateLimiterEnabled(), have.IsDispatcherRateLimiterEnabled())
 	require.Equal(t, expected.IsDispatcherOrderedExecutorMetricsEnabled(), have.IsDispatcherOrderedExecutorMetricsEnabled())
 	require.Equal(t, expected.IsControllerAutoscalerEnabled(), have.IsControllerAutoscalerEnabled())
+	require.Equal(t, expected.IsControllerPartitionExpansionEnabled(), have.IsControllerPartitionExpansionEnabled())
+	require.Equal(t, expected.IsControllerSubscriberOrderingCheckEnabled(), have.IsControllerSubscriberOrderingCheckEnabled())
+	require.Equal(t, expected.IsControllerVReplicasOversubscriptionCheckEnabled(), have.IsControllerVReplicasOversubscriptionCheckEnabled())
+	require.Equal(t, expected.IsControllerEffectiveConfigEnabled(), have.IsControllerEffectiveConfigEnabled())
+	require.Equal(t, expected.IsControllerTopicCompactionCheckEnabled(), have.IsControllerTopicCompactionCheckEnabled())
+	require.Equal(t, expected.IsControllerOverProvisionedReplicasCheckEnabled(), have.IsControllerOverProvisionedReplicasCheckEnabled())
+	require.Equal(t, expected.IsControllerStableEgressUIDEnabled(), have.IsControllerStableEgressUIDEnabled())
+	require.Equal(t, expected.IsControllerClientRackFromZoneEnabled(), have.IsControllerClientRackFromZoneEnabled())
+	require.Equal(t, expected.DispatcherReconnectBackoff(), have.DispatcherReconnectBackoff())
+	require.Equal(t, expected.DispatcherReconnectBackoffMax(), have.DispatcherReconnectBackoffMax())
+	require.Equal(t, expected.DispatcherCommitInterval(), have.DispatcherCommitInterval())
+	require.Equal(t, expected.DispatcherFetchMaxWait(), have.DispatcherFetchMaxWait())
+	require.Equal(t, expected.DispatcherKeepAlive(), have.DispatcherKeepAlive())
+	require.Equal(t, expected.ControllerMaxRetryDuration(), have.ControllerMaxRetryDuration())
+	require.Equal(t, expected.ControllerNamespaceConsumerQuota(), have.ControllerNamespaceConsumerQuota())
+	require.Equal(t, expected.ControllerNamespaceEgressQuota(), have.ControllerNamespaceEgressQuota())
+	require.Equal(t, expected.DuplicateGroupPolicy(), have.DuplicateGroupPolicy())
 	require.Equal(t, expected.features.TriggersConsumerGroupTemplate.Name(), have.features.TriggersConsumerGroupTemplate.Name())
 	require.Equal(t, expected.features.BrokersTopicTemplate.Name(), have.features.BrokersTopicTemplate.Name())
 	require.Equal(t, expected.features.ChannelsTopicTemplate.Name(), have.features.ChannelsTopicTemplate.Name())
@@ -90,6 +150,27 @@ func TestStoreLoadWithContext(t *testing.T) {
 	require.False(t, have.IsDispatcherRateLimiterEnabled())
 	require.False(t, have.IsDispatcherOrderedExecutorMetricsEnabled())
 	require.False(t, have.IsControllerAutoscalerEnabled())
+	require.False(t, have.IsControllerPartitionExpansionEnabled())
+	require.False(t, have.IsControllerSubscriberOrderingCheckEnabled())
+	require.False(t, have.IsControllerVReplicasOversubscriptionCheckEnabled())
+	require.False(t, have.IsControllerEffectiveConfigEnabled())
+	require.False(t, have.IsControllerTopicCompactionCheckEnabled())
+	require.False(t, have.IsControllerOverProvisionedReplicasCheckEnabled())
+	require.False(t, have.IsControllerStableEgressUIDEnabled())
+	require.False(t, have.IsControllerClientRackFromZoneEnabled())
+	require.Equal(t, 50*time.Millisecond, have.DispatcherReconnectBackoff())
+	require.Equal(t, time.Second, have.DispatcherReconnectBackoffMax())
+	require.Equal(t, 5*time.Second, have.DispatcherCommitInterval())
+	require.Equal(t, 500*time.Millisecond, have.DispatcherFetchMaxWait())
+	require.Equal(t, time.Minute, have.DispatcherKeepAlive())
+	require.Equal(t, 7*24*time.Hour, have.ControllerMaxRetryDuration())
+	require.Equal(t, 0, have.ControllerNamespaceConsumerQuota())
+	require.Equal(t, 0, have.ControllerNamespaceEgressQuota())
+	require.Equal(t, DuplicateGroupPolicyWarn, have.DuplicateGroupPolicy())
+	require.True(t, have.IsSubscriberHostAllowed("any.example.com"))
+	require.True(t, have.IsReplySchemeAllowed("http"))
+	require.True(t, have.IsReplySchemeAllowed("https"))
+	require.False(t, have.IsReplySchemeAllowed("ftp"))
 	require.Equal(t, have.features.TriggersConsumerGroupTemplate.Name(), "triggers.consumergroup.template")
 	require.Equal(t, have.features.BrokersTopicTemplate.Name(), "brokers.topic.template")
 	require.Equal(t, have.features.ChannelsTopicTemplate.Name(), "channels.topic.template")
@@ -187,3 +268,16 @@ func TestExecuteChannelsTopicTemplateOverride(t *testing.T) {
 
 	require.Equal(t, result, "knative-channel-namespace-topic-138ac0ec-2694-4747-900d-45be3da5c9a9")
 }
+
+func TestDuplicateGroupPolicy(t *testing.T) {
+	flags, err := NewFeaturesConfigFromMap(&corev1.ConfigMap{
+		Data: map[string]string{"controller-duplicate-group-policy": "Allow"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, DuplicateGroupPolicyAllow, flags.DuplicateGroupPolicy())
+
+	_, err = NewFeaturesConfigFromMap(&corev1.ConfigMap{
+		Data: map[string]string{"controller-duplicate-group-policy": "ignore"},
+	})
+	require.Error(t, err)
+}
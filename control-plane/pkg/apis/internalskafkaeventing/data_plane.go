@@ -32,8 +32,54 @@ const (
 	DispatcherPodKindLabelValue = "kafka-dispatcher"
 
 	DispatcherLabelSelectorStr = DataPlanePodKindLabelKey + "=" + DispatcherPodKindLabelValue
+
+	// ExpectedConsumersAnnotationKey is the dispatcher pod annotation listing the comma-separated UIDs
+	// of the Consumers the pod is expected to run.
+	ExpectedConsumersAnnotationKey = GroupName + "/expected-consumers"
+
+	// OpenCircuitsAnnotationKey is the dispatcher pod annotation, set by the dispatcher, listing the
+	// comma-separated UIDs of the Consumers whose delivery circuit breaker is open.
+	OpenCircuitsAnnotationKey = GroupName + "/open-circuits"
+
+	// DrainedConsumersAnnotationKey is the dispatcher pod annotation, set by the dispatcher, listing the
+	// comma-separated UIDs of the draining Consumers that have no more in-flight events.
+	DrainedConsumersAnnotationKey = GroupName + "/drained-consumers"
+
+	// DrainedVReplicasAnnotationKey is the dispatcher pod annotation, set by the dispatcher, listing the
+	// comma-separated UIDs of the Consumers whose removed virtual replicas are drained, it's removed once
+	// their egress is no longer signaled to drain.
+	DrainedVReplicasAnnotationKey = GroupName + "/drained-vreplicas"
+
+	// DeserializationFailingAnnotationKey is the dispatcher pod annotation, set by the dispatcher, listing the
+	// comma-separated UIDs of the Consumers with a partition paused after consecutive deserialization failures.
+	DeserializationFailingAnnotationKey = GroupName + "/deserialization-failing"
+
+	// DeliveryStalledAnnotationKey is the dispatcher pod annotation, set by the dispatcher, listing the
+	// comma-separated UIDs of the Consumers with lag but no successful delivery within their stall window.
+	DeliveryStalledAnnotationKey = GroupName + "/delivery-stalled"
+
+	// ContractSchemaVersionAnnotationKey is the dispatcher pod annotation, set by the dispatcher, with the
+	// highest contract schema version it supports.
+	ContractSchemaVersionAnnotationKey = GroupName + "/contract-schema-version"
+
+	// AuthorizationLostAnnotationKey is the dispatcher pod annotation, set by the dispatcher, listing the
+	// comma-separated UIDs of the Consumers whose principal lost access to their consumer group.
+	AuthorizationLostAnnotationKey = GroupName + "/authorization-lost"
 )
 
+// ValidateDispatcherPod returns the name of the data plane ConfigMap of the given dispatcher pod, or an
+// error naming what's missing when the given pod isn't a dispatcher pod.
+func ValidateDispatcherPod(p *corev1.Pod) (string, error) {
+	if p.Labels[DataPlanePodKindLabelKey] != DispatcherPodKindLabelValue {
+		return "", fmt.Errorf("pod %s/%s is not a dispatcher pod: expected label %s", p.GetNamespace(), p.GetName(), DispatcherLabelSelectorStr)
+	}
+	cmName, err := ConfigMapNameFromPod(p)
+	if err != nil {
+		return "", fmt.Errorf("pod %s/%s is not a dispatcher pod: %w", p.GetNamespace(), p.GetName(), err)
+	}
+	return cmName, nil
+}
+
 func ConfigMapNameFromPod(p *corev1.Pod) (string, error) {
 	var vDp *corev1.Volume
 	for i, v := range p.Spec.Volumes {
@@ -43,7 +89,7 @@ func ConfigMapNameFromPod(p *corev1.Pod) (string, error) {
 		}
 	}
 	if vDp == nil {
-		return "", fmt.Errorf("failed to get data plane volume %s in pod %s/%s", ConfigMapVolumeName, p.GetNamespace(), p.GetName())
+		return "", fmt.Errorf("failed to get data plane volume %s in pod %s/%s", DispatcherVolumeName, p.GetNamespace(), p.GetName())
 	}
 	return vDp.ConfigMap.Name, nil
 }
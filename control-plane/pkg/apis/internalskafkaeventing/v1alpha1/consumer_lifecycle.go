@@ -17,8 +17,10 @@
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/reconciler"
 )
@@ -26,6 +28,62 @@ import (
 const (
 	ConsumerConditionContract = "Contract"
 	ConsumerConditionBind     = "Bind"
+
+	// ConsumerConditionSubscriberOrdering is a warning condition, not affecting readiness,
+	// set when the subscriber can't handle the requested ordered delivery.
+	ConsumerConditionSubscriberOrdering = "SubscriberOrdering"
+
+	// ConsumerConditionVReplicasDraining is an informational condition, not affecting readiness,
+	// set when the dispatcher is asked to gracefully drain the removed virtual replicas.
+	ConsumerConditionVReplicasDraining = "VReplicasDraining"
+
+	// ConsumerConditionDeadLetterUnavailable is a warning condition, not affecting readiness,
+	// set when a dead letter sink is required but none is available.
+	ConsumerConditionDeadLetterUnavailable = "DeadLetterUnavailable"
+
+	// ConsumerConditionCircuitOpen is a warning condition, not affecting readiness,
+	// set when the dispatcher reports that the delivery circuit breaker is open.
+	ConsumerConditionCircuitOpen = "CircuitOpen"
+
+	// ConsumerConditionVReplicasOversubscribed is a warning condition, not affecting readiness,
+	// set when the bound Consumers of a consumer group have more virtual replicas than partitions.
+	ConsumerConditionVReplicasOversubscribed = "VReplicasOversubscribed"
+
+	// ConsumerConditionOverProvisionedReplicas is a warning condition, not affecting readiness,
+	// set when a Consumer with ordered delivery has more virtual replicas than partitions.
+	ConsumerConditionOverProvisionedReplicas = "OverProvisionedReplicas"
+
+	// ConsumerConditionDuplicateGroup is a warning condition, not affecting readiness,
+	// set when other Consumers bound to the same pod share a consumer group with the Consumer.
+	ConsumerConditionDuplicateGroup = "DuplicateGroup"
+
+	// ConsumerConditionDeserializationFailing is a warning condition, not affecting readiness,
+	// set when the dispatcher reports a partition paused after consecutive deserialization failures.
+	ConsumerConditionDeserializationFailing = "DeserializationFailing"
+
+	// ConsumerConditionDeliveryStalled is a warning condition, not affecting readiness,
+	// set when the dispatcher reports no successful delivery within the stall window despite the lag.
+	ConsumerConditionDeliveryStalled = "DeliveryStalled"
+
+	// ConsumerConditionContractSchemaMismatch is a warning condition, not affecting readiness,
+	// set when the dispatcher reports that it doesn't support the contract schema version.
+	ConsumerConditionContractSchemaMismatch = "ContractSchemaMismatch"
+
+	// ConsumerConditionSourceTopicMissing is a warning condition, not affecting readiness,
+	// set when some of the Consumer topics don't exist.
+	ConsumerConditionSourceTopicMissing = "SourceTopicMissing"
+
+	// ConsumerConditionTopicsCompacted is an informational condition, not affecting readiness,
+	// set when some of the Consumer topics are compacted.
+	ConsumerConditionTopicsCompacted = "TopicsCompacted"
+
+	// ConsumerConditionSubscriberTerminating is a warning condition, not affecting readiness,
+	// set while the subscriber is a pod being terminated.
+	ConsumerConditionSubscriberTerminating = "SubscriberTerminating"
+
+	// ConsumerConditionAuthorizationLost is a warning condition, not affecting readiness,
+	// set when the dispatcher reports that the principal lost access to the consumer group.
+	ConsumerConditionAuthorizationLost = "AuthorizationLost"
 )
 
 var (
@@ -39,8 +97,35 @@ func (c *Consumer) GetConditionSet() apis.ConditionSet {
 	return consumerConditionSet
 }
 
+// ContractFieldError is a contract reconcile error caused by the value of a Consumer field.
+type ContractFieldError struct {
+	// FieldPath is the path of the field, for example spec.rebalanceHooks.onAssign.
+	FieldPath string
+	Err       error
+}
+
+// NewContractFieldError returns the given error annotated with the path of the Consumer field causing it.
+func NewContractFieldError(fieldPath string, err error) error {
+	return &ContractFieldError{FieldPath: fieldPath, Err: err}
+}
+
+func (e *ContractFieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ContractFieldError) Unwrap() error {
+	return e.Err
+}
+
+// MarkReconcileContractFailed marks the contract condition as failed, the message includes the path
+// of the Consumer field causing the error, if known.
 func (c *Consumer) MarkReconcileContractFailed(err error) reconciler.Event {
-	err = fmt.Errorf("failed to reconcile contract: %w", err)
+	var fieldErr *ContractFieldError
+	if errors.As(err, &fieldErr) {
+		err = fmt.Errorf("failed to reconcile contract at %s: %w", fieldErr.FieldPath, err)
+	} else {
+		err = fmt.Errorf("failed to reconcile contract: %w", err)
+	}
 	c.GetConditionSet().Manage(c.GetStatus()).MarkFalse(ConsumerConditionContract, "ReconcileContract", err.Error())
 	return err
 }
@@ -66,3 +151,216 @@ func (c *Consumer) MarkBindInProgressWithMessage(messageFormat string, messageA
 func (c *Consumer) MarkBindSucceeded() {
 	c.GetConditionSet().Manage(c.GetStatus()).MarkTrue(ConsumerConditionBind)
 }
+
+func (c *Consumer) MarkSubscriberOrderingUnsupported(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionSubscriberOrdering,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "OrderedDeliveryUnsupported",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearSubscriberOrdering() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionSubscriberOrdering)
+}
+
+func (c *Consumer) MarkVReplicasDraining(from, to int32) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionVReplicasDraining,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   "VReplicasDecreased",
+		Message:  fmt.Sprintf("Draining virtual replicas from %d to %d", from, to),
+	})
+}
+
+func (c *Consumer) ClearVReplicasDraining() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionVReplicasDraining)
+}
+
+func (c *Consumer) MarkDeadLetterUnavailable(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionDeadLetterUnavailable,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "DeadLetterRequired",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearDeadLetterUnavailable() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionDeadLetterUnavailable)
+}
+
+func (c *Consumer) MarkCircuitOpen(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionCircuitOpen,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "ConsecutiveDeliveryFailures",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearCircuitOpen() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionCircuitOpen)
+}
+
+func (c *Consumer) MarkDeserializationFailing(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionDeserializationFailing,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "ConsecutiveDeserializationFailures",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearDeserializationFailing() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionDeserializationFailing)
+}
+
+func (c *Consumer) MarkDeliveryStalled(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionDeliveryStalled,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "NoSuccessfulDelivery",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearDeliveryStalled() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionDeliveryStalled)
+}
+
+func (c *Consumer) MarkContractSchemaMismatch(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionContractSchemaMismatch,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "IncompatibleSchemaVersion",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearContractSchemaMismatch() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionContractSchemaMismatch)
+}
+
+func (c *Consumer) MarkSourceTopicMissing(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionSourceTopicMissing,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "TopicNotFound",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearSourceTopicMissing() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionSourceTopicMissing)
+}
+
+func (c *Consumer) MarkTopicsCompacted(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionTopicsCompacted,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   "CleanupPolicyCompact",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearTopicsCompacted() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionTopicsCompacted)
+}
+
+// HasCompactedTopics returns whether some of the Consumer topics are known to be compacted.
+func (c *Consumer) HasCompactedTopics() bool {
+	return c.Status.GetCondition(ConsumerConditionTopicsCompacted).IsTrue()
+}
+
+// MarkPartitionsExpanded records the expansion of the partitions of the given topic.
+func (c *Consumer) MarkPartitionsExpanded(topic string, from, to int32) {
+	expansion := PartitionExpansion{Topic: topic, FromPartitions: from, ToPartitions: to}
+	for i := range c.Status.PartitionExpansions {
+		if c.Status.PartitionExpansions[i].Topic == topic {
+			c.Status.PartitionExpansions[i] = expansion
+			return
+		}
+	}
+	c.Status.PartitionExpansions = append(c.Status.PartitionExpansions, expansion)
+}
+
+func (c *Consumer) MarkVReplicasOversubscribed(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionVReplicasOversubscribed,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "VReplicasExceedPartitions",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearVReplicasOversubscribed() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionVReplicasOversubscribed)
+}
+
+func (c *Consumer) MarkOverProvisionedReplicas(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionOverProvisionedReplicas,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "OrderedVReplicasExceedPartitions",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearOverProvisionedReplicas() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionOverProvisionedReplicas)
+}
+
+func (c *Consumer) MarkDuplicateGroup(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionDuplicateGroup,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "ConsumerGroupShared",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearDuplicateGroup() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionDuplicateGroup)
+}
+
+func (c *Consumer) MarkSubscriberTerminating(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionSubscriberTerminating,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "SubscriberPodTerminating",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearSubscriberTerminating() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionSubscriberTerminating)
+}
+
+func (c *Consumer) MarkAuthorizationLost(messageFormat string, messageA ...interface{}) {
+	c.GetConditionSet().Manage(c.GetStatus()).SetCondition(apis.Condition{
+		Type:     ConsumerConditionAuthorizationLost,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "ConsumerGroupAuthorizationFailed",
+		Message:  fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+func (c *Consumer) ClearAuthorizationLost() {
+	_ = c.GetConditionSet().Manage(c.GetStatus()).ClearCondition(ConsumerConditionAuthorizationLost)
+}
@@ -19,8 +19,10 @@ package v1alpha1
 import (
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -93,6 +95,12 @@ type ConsumerSpec struct {
 	// Subscriber is the addressable that receives events that pass the Filters.
 	Subscriber duckv1.Destination `json:"subscriber"`
 
+	// SubscriberClientCertSecretRef is a secret of type kubernetes.io/tls, in the Consumer namespace,
+	// holding the client certificate and key, in the tls.crt and tls.key keys, the dispatcher presents
+	// to the subscriber for mutual TLS.
+	// +optional
+	SubscriberClientCertSecretRef *corev1.LocalObjectReference `json:"subscriberClientCertSecretRef,omitempty"`
+
 	// CloudEventOverrides defines overrides to control the output format and
 	// modifications of the event sent to the subscriber.
 	// +optional
@@ -107,20 +115,180 @@ type ConsumerSpec struct {
 	// OIDCServiceAccountName is the name of the generated service account
 	// used for this components OIDC authentication.
 	OIDCServiceAccountName *string `json:"oidcServiceAccountName,omitempty"`
+
+	// FeatureFlagOverrides are feature flags that override, for this Consumer only,
+	// the cluster-wide feature flags.
+	// Keys are flag names and values are either "enabled" or "disabled".
+	// +optional
+	FeatureFlagOverrides map[string]string `json:"featureFlagOverrides,omitempty"`
+
+	// TopicPartitions is the desired number of partitions for each topic in Topics.
+	// When partition expansion is enabled and a topic has fewer partitions, the
+	// partitions are increased to this number; they are never decreased.
+	// +optional
+	TopicPartitions *int32 `json:"topicPartitions,omitempty"`
+
+	// TopicRoutes routes the events of specific topics to a dedicated subscriber,
+	// events of topics without a route are sent to Subscriber.
+	// +optional
+	TopicRoutes []TopicRoute `json:"topicRoutes,omitempty"`
+
+	// RebalanceHooks are the addressables called when partitions are revoked
+	// from or assigned to the Consumer.
+	// +optional
+	RebalanceHooks *RebalanceHooks `json:"rebalanceHooks,omitempty"`
+
+	// ExpectedThroughput is the expected number of events per second, it's used
+	// for capacity planning and doesn't change the delivery.
+	// +optional
+	ExpectedThroughput *int32 `json:"expectedThroughput,omitempty"`
+
+	// FinalizeDrainTimeout is how long, since the deletion of the Consumer, the dispatcher
+	// is given to complete the in-flight events before the Consumer is removed from the contract.
+	// When unset, the Consumer is removed immediately.
+	// +optional
+	FinalizeDrainTimeout *metav1.Duration `json:"finalizeDrainTimeout,omitempty"`
+
+	// ShadowSubscriber receives a copy of the events, for testing a new subscriber against
+	// live traffic; its delivery outcome never affects the committed offsets.
+	// When unset, no copy is sent.
+	// +optional
+	ShadowSubscriber *duckv1.Destination `json:"shadowSubscriber,omitempty"`
+
+	// ShadowSamplePercent is the percentage, from 0 to 100, of the events sent to ShadowSubscriber.
+	// When unset, every event is sent.
+	// +optional
+	ShadowSamplePercent *int32 `json:"shadowSamplePercent,omitempty"`
+
+	// FetchConcurrency is the maximum number of partitions the dispatcher fetches from in parallel.
+	// Events of the same partition are still fetched sequentially, so ordered delivery keeps
+	// the per-partition order while events of different partitions are interleaved.
+	// When unset, the dispatcher default is used.
+	// +optional
+	FetchConcurrency *int32 `json:"fetchConcurrency,omitempty"`
+
+	// RequiredExtensions are the CloudEvent extensions an event must have to be sent to the subscriber,
+	// events missing any of them are handled according to OnMissingExtensions.
+	// +optional
+	RequiredExtensions []string `json:"requiredExtensions,omitempty"`
+
+	// OnMissingExtensions is the policy to handle events missing any of the RequiredExtensions,
+	// it defaults to deadletter.
+	// +optional
+	OnMissingExtensions *MissingExtensionsPolicy `json:"onMissingExtensions,omitempty"`
+
+	// Audit configures the audit records written for the delivery attempts.
+	// When unset, no audit record is written.
+	// +optional
+	Audit *Audit `json:"audit,omitempty"`
+}
+
+// MissingExtensionsPolicy is the policy to handle an event missing a required extension.
+type MissingExtensionsPolicy string
+
+const (
+	// MissingExtensionsDeadLetter sends the event to the dead letter sink.
+	MissingExtensionsDeadLetter MissingExtensionsPolicy = "deadletter"
+	// MissingExtensionsDrop discards the event.
+	MissingExtensionsDrop MissingExtensionsPolicy = "drop"
+)
+
+// RebalanceHooks are the addressables called on consumer group rebalances.
+type RebalanceHooks struct {
+	// OnRevoke is called before partitions are revoked from the Consumer.
+	// +optional
+	OnRevoke *duckv1.Destination `json:"onRevoke,omitempty"`
+
+	// OnAssign is called after partitions are assigned to the Consumer.
+	// +optional
+	OnAssign *duckv1.Destination `json:"onAssign,omitempty"`
+}
+
+// Audit configures the audit records of the delivery attempts, each record has the event ID,
+// the delivery result and the attempt timestamp.
+type Audit struct {
+	// Topic is the Kafka topic the audit records are written to, using the Consumer
+	// bootstrap servers and auth.
+	Topic string `json:"topic"`
+
+	// Level selects the delivery attempts that are audited, it defaults to all.
+	// +optional
+	Level *AuditLevel `json:"level,omitempty"`
+}
+
+// AuditLevel selects the delivery attempts that are audited.
+type AuditLevel string
+
+const (
+	// AuditLevelAll audits every delivery attempt.
+	AuditLevelAll AuditLevel = "all"
+	// AuditLevelFailures audits the failed delivery attempts only.
+	AuditLevelFailures AuditLevel = "failures"
+)
+
+// TopicRoute routes the events of a topic to a subscriber.
+type TopicRoute struct {
+	// Topic is one of the Consumer topics.
+	Topic string `json:"topic"`
+
+	// Subscriber is the addressable that receives the events of Topic that pass the Filters.
+	Subscriber duckv1.Destination `json:"subscriber"`
 }
 
 type ReplyStrategy struct {
 	TopicReply *TopicReply       `json:"topicReply,omitempty"`
 	URLReply   *DestinationReply `json:"URLReply,omitempty"`
 	NoReply    *NoReply          `json:"noReply,omitempty"`
+
+	// OnMalformed is how to handle a reply that isn't a valid CloudEvent,
+	// it can be set only with TopicReply or URLReply.
+	// Default value: error
+	// +optional
+	OnMalformed *MalformedReplyPolicy `json:"onMalformed,omitempty"`
 }
 
+// MalformedReplyPolicy is the policy to handle a reply that isn't a valid CloudEvent.
+type MalformedReplyPolicy string
+
+const (
+	// MalformedReplyDrop discards the reply.
+	MalformedReplyDrop MalformedReplyPolicy = "drop"
+	// MalformedReplyDeadLetter sends the event to the dead letter sink.
+	MalformedReplyDeadLetter MalformedReplyPolicy = "deadletter"
+	// MalformedReplyError considers the reply a delivery failure.
+	MalformedReplyError MalformedReplyPolicy = "error"
+)
+
 type NoReply struct {
 	Enabled bool `json:"enabled"`
 }
 
 type TopicReply struct {
 	Enabled bool `json:"enabled"`
+
+	// KeyAttribute is the CloudEvent attribute, for example the `partitionkey` extension,
+	// used as the key of the reply records, so that ordering is preserved on the reply topic.
+	// When unset, the key of the original record is used.
+	// +optional
+	KeyAttribute *string `json:"keyAttribute,omitempty"`
+
+	// BootstrapServers are the comma separated bootstrap servers of the Kafka cluster the replies
+	// are produced to, for example to consume from a remote cluster and reply to the local one.
+	// When unset, the replies are produced to the Kafka cluster the events are consumed from.
+	// +optional
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+
+	// NetSpec is the auth of the Kafka cluster the replies are produced to, it can be set only
+	// with BootstrapServers.
+	// When unset, the replies are produced without auth.
+	// +optional
+	NetSpec *bindings.KafkaNetSpec `json:"netSpec,omitempty"`
+
+	// MaxProducers is the maximum number of producers concurrently producing the replies, to
+	// bound the connections opened to the Kafka cluster, it must be at least 1.
+	// When unset, the number of reply producers is unbounded.
+	// +optional
+	MaxProducers *int32 `json:"maxProducers,omitempty"`
 }
 
 type DestinationReply struct {
@@ -133,6 +301,44 @@ type Auth struct {
 	// Deprecated, use secret spec
 	AuthSpec   *eventingv1alpha1.Auth `json:"AuthSpec,omitempty"`
 	SecretSpec *SecretSpec            `json:"SecretSpec,omitempty"`
+
+	// TLS contains the TLS settings for the connection to the Kafka cluster.
+	// +optional
+	TLS *TLSSpec `json:"tls,omitempty"`
+}
+
+type TLSSpec struct {
+	// ServerName is the server name indication (SNI) sent on the TLS handshake,
+	// it is useful when connecting through a proxy.
+	// When unset, the bootstrap server host is used.
+	// +optional
+	ServerName *string `json:"serverName,omitempty"`
+
+	// MinVersion is the minimum TLS protocol version allowed on the handshake.
+	// Possible values:
+	// - "TLSv1.2"
+	// - "TLSv1.3"
+	//
+	// When unset, the dispatcher default is used.
+	// +optional
+	MinVersion *string `json:"minVersion,omitempty"`
+
+	// CipherSuites are the IANA names of the cipher suites allowed on the handshake,
+	// for example TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+	// When unset, the dispatcher default cipher suites are used.
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
+
+const (
+	TLSVersion12 = "TLSv1.2"
+	TLSVersion13 = "TLSv1.3"
+)
+
+// TLSVersionAllowed are the allowed values of TLSSpec.MinVersion.
+var TLSVersionAllowed = []string{
+	TLSVersion12,
+	TLSVersion13,
 }
 
 type SecretSpec struct {
@@ -150,6 +356,10 @@ func (a *SecretSpec) HasSecret() bool {
 		a.Ref.Name != "" && a.Ref.Namespace != ""
 }
 
+// DeliveryAttemptsExtension is the CloudEvent extension carrying the number of attempts it took
+// to deliver an event.
+const DeliveryAttemptsExtension = "knativekafkaattempts"
+
 type DeliverySpec struct {
 	// DeliverySpec is the Knative core delivery spec.
 	// DeliverySpec contains the delivery options for event senders.
@@ -161,11 +371,194 @@ type DeliverySpec struct {
 	// InitialOffset initial offset.
 	InitialOffset sources.Offset `json:"initialOffset"`
 
+	// RetryDeadline is the time budget for retrying the delivery of an event,
+	// once elapsed the event is sent to the dead letter sink.
+	// When set, it overrides the retry count.
+	// +optional
+	RetryDeadline *metav1.Duration `json:"retryDeadline,omitempty"`
+
+	// MaxRetryDuration is the wall-clock time after which the retries of an event stop,
+	// even when the retry count isn't exhausted, and the event is sent to the dead letter sink.
+	// Unlike RetryDeadline, it doesn't override the retry count, the retries stop at the first
+	// of the two limits reached.
+	// When unset, the retries are only bounded by the retry count.
+	// +optional
+	MaxRetryDuration *metav1.Duration `json:"maxRetryDuration,omitempty"`
+
+	// EmitDeliveryAttempts sets the DeliveryAttemptsExtension extension on the events
+	// sent to the subscriber with the number of attempts it took to deliver them.
+	// +optional
+	EmitDeliveryAttempts bool `json:"emitDeliveryAttempts,omitempty"`
+
+	// UserAgent is the User-Agent header of the HTTP requests sent to the subscriber.
+	// When unset, the dispatcher default User-Agent is used.
+	// +optional
+	UserAgent *string `json:"userAgent,omitempty"`
+
+	// DeadLetterRequired, when true, pauses the consumption instead of dropping events
+	// that can't be written to the dead letter sink.
+	// +optional
+	DeadLetterRequired *bool `json:"deadLetterRequired,omitempty"`
+
+	// DeadLetterReasonExtension is the name of the CloudEvent extension set on the events
+	// sent to the dead letter sink, describing why the delivery failed.
+	// +optional
+	DeadLetterReasonExtension *string `json:"deadLetterReasonExtension,omitempty"`
+
+	// DeadLetterOriginalMetadata sets the originaltopic, originalpartition and originaloffset
+	// extensions, describing the record the event was read from, on the events sent to the
+	// dead letter sink.
+	// +optional
+	DeadLetterOriginalMetadata bool `json:"deadLetterOriginalMetadata,omitempty"`
+
+	// CircuitBreaker pauses the delivery after a number of consecutive delivery failures.
+	// When unset, the circuit breaker is disabled.
+	// +optional
+	CircuitBreaker *CircuitBreakerSpec `json:"circuitBreaker,omitempty"`
+
+	// MaxAttempts is the maximum number of delivery attempts of an event, counted across
+	// retries and dispatcher reconnects, after which the event is sent to the dead letter sink,
+	// or dropped when there is none.
+	// When unset, the attempts are only bounded by the retry policy.
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+
+	// PayloadFormat is the format of the payload sent to the subscriber, it defaults to cloudevent.
+	// With raw, the Kafka record value is sent as the HTTP request body and each record header is
+	// sent as an HTTP header with the same name and value, the record key isn't sent.
+	// It's named PayloadFormat since Format is the CloudEvents event format of the core delivery spec.
+	// +optional
+	PayloadFormat *PayloadFormat `json:"payloadFormat,omitempty"`
+
+	// DeadLetterContentMode is the CloudEvents content mode of the events sent to the dead letter sink.
+	// Possible values:
+	// - "binary"
+	// - "structured"
+	//
+	// When unset, the content mode of the delivery to the subscriber is used.
+	// +optional
+	DeadLetterContentMode *string `json:"deadLetterContentMode,omitempty"`
+
+	// DeadLetterCACerts are the Certification Authority (CA) certificates in PEM format used to
+	// verify the dead letter sink TLS certificate.
+	// When set, they're used instead of the CA certificates resolved from the dead letter sink.
+	// +optional
+	DeadLetterCACerts *string `json:"deadLetterCACerts,omitempty"`
+
+	// DedupWindow is the time window within which the dispatcher drops the events whose
+	// CloudEvent id was already delivered.
+	// The dispatcher keeps the ids delivered within the window in memory, so its memory usage
+	// grows with the window and the event throughput, keep the window as short as possible.
+	// When unset, the events aren't deduplicated.
+	// +optional
+	DedupWindow *metav1.Duration `json:"dedupWindow,omitempty"`
+
+	// StallWindow is the time without a successful delivery, while the Consumer has lag, after which
+	// the dispatcher reports the delivery as stalled, surfaced as the DeliveryStalled condition.
+	// When unset, the stall detection is disabled.
+	// +optional
+	StallWindow *metav1.Duration `json:"stallWindow,omitempty"`
+
+	// OversizeHandling is how the dispatcher handles the events whose size exceeds OversizeThresholdBytes,
+	// which are otherwise retried like any other failing event.
+	// +optional
+	OversizeHandling *OversizeHandling `json:"oversizeHandling,omitempty"`
+
+	// OversizeThresholdBytes is the size above which an event is handled according to OversizeHandling,
+	// it's required when OversizeHandling is set.
+	// +optional
+	OversizeThresholdBytes *int32 `json:"oversizeThresholdBytes,omitempty"`
+
+	// CommitMode is when the offset of an event is committed, it defaults to on-success.
+	// With on-success the offset is committed once the event is delivered, or sent to the dead letter
+	// sink, so the events being delivered when the dispatcher stops are delivered again: no event is
+	// lost but some can be duplicated (at-least-once).
+	// With on-receipt the offset is committed as soon as the event is read, so the events being
+	// delivered when the dispatcher stops aren't delivered again: no event is duplicated but some
+	// can be lost (at-most-once).
+	// +optional
+	CommitMode *CommitMode `json:"commitMode,omitempty"`
+
+	// Signing signs the events delivered to the subscriber with an HMAC key, so that the subscriber
+	// can verify them.
+	// When unset, the events aren't signed.
+	// +optional
+	Signing *DeliverySigning `json:"signing,omitempty"`
+
 	// TODO Add rate limiting
 
 	// TODO PT OPT
 }
 
+// PayloadFormat is the format of the payload sent to the subscriber.
+type PayloadFormat string
+
+const (
+	// PayloadFormatCloudEvent sends the CloudEvent.
+	PayloadFormatCloudEvent PayloadFormat = "cloudevent"
+	// PayloadFormatRaw sends the Kafka record value with the record headers.
+	PayloadFormatRaw PayloadFormat = "raw"
+)
+
+// OversizeHandling is how the dispatcher handles the events exceeding the size threshold.
+type OversizeHandling string
+
+const (
+	// OversizeHandlingDeadLetter sends the oversized events to the dead letter sink, if any.
+	OversizeHandlingDeadLetter OversizeHandling = "deadletter"
+	// OversizeHandlingSkip drops the oversized events and commits their offsets.
+	OversizeHandlingSkip OversizeHandling = "skip"
+	// OversizeHandlingTruncate truncates the payload of the oversized events to the size threshold.
+	OversizeHandlingTruncate OversizeHandling = "truncate"
+)
+
+// CommitMode is when the offset of an event is committed.
+type CommitMode string
+
+const (
+	// CommitModeOnSuccess commits the offset of an event once it's delivered (at-least-once).
+	CommitModeOnSuccess CommitMode = "on-success"
+	// CommitModeOnReceipt commits the offset of an event as soon as it's read (at-most-once).
+	CommitModeOnReceipt CommitMode = "on-receipt"
+)
+
+// DeliverySigning configures the HMAC signing of the events delivered to the subscriber.
+// The dispatcher sends the signature of the request body in the X-Signature header, as
+// `<algorithm>=<hex encoded HMAC>`.
+type DeliverySigning struct {
+	// SecretName is the name of the secret, in the Consumer namespace, holding the HMAC key.
+	SecretName string `json:"secretName"`
+
+	// SecretKey is the key of the HMAC key in the secret.
+	SecretKey string `json:"secretKey"`
+
+	// Algorithm is the HMAC algorithm.
+	// Possible values:
+	// - "hmac-sha256"
+	// - "hmac-sha512"
+	Algorithm SigningAlgorithm `json:"algorithm"`
+}
+
+// SigningAlgorithm is the HMAC algorithm the events delivered to the subscriber are signed with.
+type SigningAlgorithm string
+
+const (
+	// SigningAlgorithmHMACSHA256 signs the events with HMAC-SHA256.
+	SigningAlgorithmHMACSHA256 SigningAlgorithm = "hmac-sha256"
+	// SigningAlgorithmHMACSHA512 signs the events with HMAC-SHA512.
+	SigningAlgorithmHMACSHA512 SigningAlgorithm = "hmac-sha512"
+)
+
+// CircuitBreakerSpec configures the circuit breaker of the delivery.
+type CircuitBreakerSpec struct {
+	// FailureThreshold is the number of consecutive delivery failures, across all events,
+	// after which the circuit opens and the delivery pauses.
+	FailureThreshold int32 `json:"failureThreshold"`
+
+	// PauseDuration is how long the delivery pauses once the circuit opens.
+	PauseDuration metav1.Duration `json:"pauseDuration"`
+}
+
 // ConsumerConfigs are the Consumer configurations.
 // More info: https://kafka.apache.org/documentation/#consumerconfigs
 type ConsumerConfigs struct {
@@ -181,8 +574,344 @@ type ConsumerConfigs struct {
 	//
 	// Default value: string
 	KeyType *string `json:"keyType,omitempty"`
+
+	// StripHeaders are the headers to strip from the events sent to the subscriber,
+	// in addition to the hop-by-hop headers that are always stripped.
+	// +optional
+	StripHeaders []string `json:"stripHeaders,omitempty"`
+
+	// ReconnectBackoff is the backoff before reconnecting to a broker.
+	// When unset, the cluster-wide default is used.
+	// +optional
+	ReconnectBackoff *metav1.Duration `json:"reconnectBackoff,omitempty"`
+
+	// ReconnectBackoffMax is the maximum backoff before reconnecting to a broker
+	// that repeatedly failed to connect, it must be greater than or equal to ReconnectBackoff.
+	// When unset, the cluster-wide default is used.
+	// +optional
+	ReconnectBackoffMax *metav1.Duration `json:"reconnectBackoffMax,omitempty"`
+
+	// CommitInterval is the interval between the periodic commits of the consumed offsets,
+	// it can't be set together with the `auto.commit.interval.ms` config, nor when the offsets
+	// aren't committed periodically, with `enable.auto.commit` false or the on-receipt commit mode.
+	// When unset, the cluster-wide default is used.
+	// +optional
+	CommitInterval *metav1.Duration `json:"commitInterval,omitempty"`
+
+	// FetchMaxWait is the maximum time a fetch request waits on the Kafka broker for data,
+	// it can't be set together with the `fetch.max.wait.ms` config.
+	// When unset, the cluster-wide default is used.
+	// +optional
+	FetchMaxWait *metav1.Duration `json:"fetchMaxWait,omitempty"`
+
+	// FetchMaxBytes is the maximum bytes returned by a fetch request, it caps the
+	// memory used by the Consumer fetch buffer.
+	// +optional
+	FetchMaxBytes *int32 `json:"fetchMaxBytes,omitempty"`
+
+	// ReceiveBufferBytes is the size of the TCP receive buffer.
+	// +optional
+	ReceiveBufferBytes *int32 `json:"receiveBufferBytes,omitempty"`
+
+	// ContentMode is the CloudEvents content mode of the events sent to the subscriber.
+	// Possible values:
+	// - "binary"
+	// - "structured"
+	//
+	// Default value: binary
+	// +optional
+	ContentMode *string `json:"contentMode,omitempty"`
+
+	// PartitionAssignor is the partition assignment strategy of the classic consumer group protocol.
+	// Possible values:
+	// - "range"
+	// - "roundrobin"
+	// - "sticky"
+	// - "cooperative-sticky"
+	//
+	// Default value: cooperative-sticky
+	// +optional
+	PartitionAssignor *string `json:"partitionAssignor,omitempty"`
+
+	// FollowRedirects makes the dispatcher follow a bounded number of 3xx redirect
+	// responses from the subscriber, otherwise they're treated as delivery failures.
+	//
+	// Default value: false
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+
+	// LagBasedScaleTarget is the consumer lag each replica is expected to handle,
+	// it's a hint for the dispatcher or an autoscaler to derive the desired scale from the lag.
+	// +optional
+	LagBasedScaleTarget *int32 `json:"lagBasedScaleTarget,omitempty"`
+
+	// SubscriberMaxConns is the maximum number of concurrent HTTP connections the dispatcher
+	// opens to the subscriber.
+	// When unset, the dispatcher default is used.
+	// +optional
+	SubscriberMaxConns *int32 `json:"subscriberMaxConns,omitempty"`
+
+	// SubscriberMaxIdleConns is the maximum number of idle HTTP connections to the subscriber
+	// the dispatcher keeps open for reuse, how long they're kept is controlled by KeepAlive.
+	// When unset, the dispatcher default is used.
+	// +optional
+	SubscriberMaxIdleConns *int32 `json:"subscriberMaxIdleConns,omitempty"`
+
+	// OnTopicDeleted is how the Consumer reacts when one of its topics is deleted.
+	// Possible values:
+	// - "wait": the dispatcher waits for the topic to be created again
+	// - "error": the Consumer reconciliation fails until the topic is created again
+	// - "recreate": the topic is created again with the TopicPartitions partitions
+	//
+	// Default value: wait
+	// +optional
+	OnTopicDeleted *string `json:"onTopicDeleted,omitempty"`
+
+	// MetricsSampleRate is the percentage, from 0 to 100, of the delivered events the dispatcher
+	// records delivery metrics for.
+	//
+	// Default value: 100
+	// +optional
+	MetricsSampleRate *int32 `json:"metricsSampleRate,omitempty"`
+
+	// OrderingFallback is how the dispatcher delivers the events it can't deliver in order, like the
+	// records without a key, it's only allowed with ordered delivery.
+	// Possible values:
+	// - "unordered": the events are delivered without ordering guarantees
+	// - "error": the events are handled as delivery failures
+	//
+	// Default value: unordered
+	// +optional
+	OrderingFallback *string `json:"orderingFallback,omitempty"`
+
+	// OrderingKeyHeader is the name of the record header whose value the dispatcher uses as the
+	// ordering key, instead of the record key, it's only allowed with ordered delivery.
+	// When unset, the record key is used.
+	// +optional
+	OrderingKeyHeader *string `json:"orderingKeyHeader,omitempty"`
+
+	// OnTombstone is how the dispatcher handles the tombstones, the records without a value, which
+	// mark the deletion of the records with the same key on compacted topics.
+	// Possible values:
+	// - "deliver": the tombstones are delivered as events without data
+	// - "skip": the tombstones are committed without being delivered
+	//
+	// Default value: skip when the Consumer topics are known to be compacted, deliver otherwise
+	// +optional
+	OnTombstone *string `json:"onTombstone,omitempty"`
+
+	// RebalanceTimeout is the maximum time the dispatcher can take to process the polled events,
+	// after which the consumer leaves the consumer group and its partitions are rebalanced, it's
+	// the Kafka client `max.poll.interval.ms`.
+	// When unset, the Kafka client default is used.
+	// +optional
+	RebalanceTimeout *metav1.Duration `json:"rebalanceTimeout,omitempty"`
+
+	// TimeSource is the source of the CloudEvent time attribute of the events.
+	// Possible values:
+	// - "kafka-timestamp": the record timestamp
+	// - "header": the value of the TimeSourceHeader record header
+	// - "now": the time the event is dispatched
+	//
+	// Default value: kafka-timestamp
+	// +optional
+	TimeSource *string `json:"timeSource,omitempty"`
+
+	// TimeSourceHeader is the name of the record header the CloudEvent time attribute is read from,
+	// it's required with the "header" TimeSource and not allowed otherwise.
+	// +optional
+	TimeSourceHeader *string `json:"timeSourceHeader,omitempty"`
+
+	// FlowControlWebhook is the addressable of the external controller pausing and resuming
+	// the Consumer partitions through the dispatcher flow control webhook.
+	// When unset, the partitions are paused and resumed by the dispatcher only.
+	// +optional
+	FlowControlWebhook *duckv1.Destination `json:"flowControlWebhook,omitempty"`
+
+	// AdditionalGroupID is a consumer group the Consumer topics are consumed under in addition
+	// to the `group.id` one, delivering the events of both groups to the same subscriber, for
+	// example while migrating from a consumer group to another.
+	// It must differ from `group.id`, and removing it stops the consumption under it only.
+	// +optional
+	AdditionalGroupID *string `json:"additionalGroupId,omitempty"`
+
+	// HonorRetryAfter makes the dispatcher delay the next delivery by the value of the
+	// Retry-After header returned by the subscriber, up to a cap.
+	//
+	// Default value: false
+	// +optional
+	HonorRetryAfter *bool `json:"honorRetryAfter,omitempty"`
+
+	// ShutdownGrace is the maximum time the dispatcher waits, when the Consumer is stopped,
+	// for the in-flight events to be delivered and their offsets committed.
+	// When unset, the dispatcher default is used.
+	// +optional
+	ShutdownGrace *metav1.Duration `json:"shutdownGrace,omitempty"`
+
+	// DeliveryProxyURL is the URL of the proxy the dispatcher sends the events to the subscriber through.
+	// Supported schemes: http, https, socks5.
+	// +optional
+	DeliveryProxyURL *string `json:"deliveryProxyURL,omitempty"`
+
+	// NullKeyPolicy is how the dispatcher handles the records without a key, which have no
+	// defined order when the delivery is key ordered.
+	// Possible values:
+	// - "roundrobin"
+	// - "reject"
+	// - "drop"
+	//
+	// Default value: roundrobin
+	// +optional
+	NullKeyPolicy *string `json:"nullKeyPolicy,omitempty"`
+
+	// RequestTimeout is the timeout of each HTTP request sent to the subscriber, it's distinct
+	// from the delivery timeout.
+	// When unset, the delivery timeout is used.
+	// +optional
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+
+	// IdGenerationStrategy is how the dispatcher generates the id of the records without a CloudEvent id.
+	// Possible values:
+	// - "uuid"
+	// - "topic-partition-offset"
+	//
+	// Default value: uuid
+	// +optional
+	IdGenerationStrategy *string `json:"idGenerationStrategy,omitempty"`
+
+	// MaxConsecutiveDeserFailures is the number of consecutive deserialization failures of the
+	// records of a partition after which the dispatcher pauses the partition, so that a flood
+	// of malformed records doesn't cause a tight failure loop.
+	// When unset, the partition is never paused.
+	// +optional
+	MaxConsecutiveDeserFailures *int32 `json:"maxConsecutiveDeserFailures,omitempty"`
+
+	// KeepAlive is the time an idle HTTP connection to the subscriber is kept open for reuse.
+	// When zero, idle connections are closed right away.
+	// When unset, the cluster-wide default is used.
+	// +optional
+	KeepAlive *metav1.Duration `json:"keepAlive,omitempty"`
+
+	// OnAuthorizationFailure is how the dispatcher reacts when the principal loses access to the
+	// consumer group while consuming, surfaced as the AuthorizationLost condition.
+	// Possible values:
+	// - "retry-with-backoff": the dispatcher retries with backoff until the access is granted again
+	// - "pause": the dispatcher pauses the consumption until the Consumer is updated
+	// - "fail-fast": the dispatcher stops consuming and reports the failure
+	//
+	// Default value: retry-with-backoff
+	// +optional
+	OnAuthorizationFailure *string `json:"onAuthorizationFailure,omitempty"`
+}
+
+// CoordinatorDiscoveryTimeoutConfig is the consumer config key of the time, in milliseconds,
+// the dispatcher waits for the consumer group coordinator to be discovered.
+// It isn't a Kafka client config, the dispatcher removes it from the client configs.
+const CoordinatorDiscoveryTimeoutConfig = "coordinator.discovery.timeout.ms"
+
+const (
+	PartitionAssignorRange             = "range"
+	PartitionAssignorRoundRobin        = "roundrobin"
+	PartitionAssignorSticky            = "sticky"
+	PartitionAssignorCooperativeSticky = "cooperative-sticky"
+)
+
+// PartitionAssignorAllowed are the allowed values of ConsumerConfigs.PartitionAssignor.
+var PartitionAssignorAllowed = []string{
+	PartitionAssignorRange,
+	PartitionAssignorRoundRobin,
+	PartitionAssignorSticky,
+	PartitionAssignorCooperativeSticky,
+}
+
+const (
+	NullKeyPolicyRoundRobin = "roundrobin"
+	NullKeyPolicyReject     = "reject"
+	NullKeyPolicyDrop       = "drop"
+)
+
+// NullKeyPolicyAllowed are the allowed values of ConsumerConfigs.NullKeyPolicy.
+var NullKeyPolicyAllowed = []string{
+	NullKeyPolicyRoundRobin,
+	NullKeyPolicyReject,
+	NullKeyPolicyDrop,
+}
+
+const (
+	IdGenerationStrategyUUID                 = "uuid"
+	IdGenerationStrategyTopicPartitionOffset = "topic-partition-offset"
+)
+
+// IdGenerationStrategyAllowed are the allowed values of ConsumerConfigs.IdGenerationStrategy.
+var IdGenerationStrategyAllowed = []string{
+	IdGenerationStrategyUUID,
+	IdGenerationStrategyTopicPartitionOffset,
+}
+
+const (
+	OnTopicDeletedWait     = "wait"
+	OnTopicDeletedError    = "error"
+	OnTopicDeletedRecreate = "recreate"
+)
+
+// OnTopicDeletedAllowed are the allowed values of ConsumerConfigs.OnTopicDeleted.
+var OnTopicDeletedAllowed = []string{
+	OnTopicDeletedWait,
+	OnTopicDeletedError,
+	OnTopicDeletedRecreate,
+}
+
+const (
+	OnAuthorizationFailureRetryWithBackoff = "retry-with-backoff"
+	OnAuthorizationFailurePause            = "pause"
+	OnAuthorizationFailureFailFast         = "fail-fast"
+)
+
+// OnAuthorizationFailureAllowed are the allowed values of ConsumerConfigs.OnAuthorizationFailure.
+var OnAuthorizationFailureAllowed = []string{
+	OnAuthorizationFailureRetryWithBackoff,
+	OnAuthorizationFailurePause,
+	OnAuthorizationFailureFailFast,
+}
+
+const (
+	OrderingFallbackUnordered = "unordered"
+	OrderingFallbackError     = "error"
+)
+
+// OrderingFallbackAllowed are the allowed values of ConsumerConfigs.OrderingFallback.
+var OrderingFallbackAllowed = []string{
+	OrderingFallbackUnordered,
+	OrderingFallbackError,
 }
 
+const (
+	OnTombstoneDeliver = "deliver"
+	OnTombstoneSkip    = "skip"
+)
+
+// OnTombstoneAllowed are the allowed values of ConsumerConfigs.OnTombstone.
+var OnTombstoneAllowed = []string{
+	OnTombstoneDeliver,
+	OnTombstoneSkip,
+}
+
+const (
+	TimeSourceKafkaTimestamp = "kafka-timestamp"
+	TimeSourceHeader         = "header"
+	TimeSourceNow            = "now"
+)
+
+// TimeSourceAllowed are the allowed values of ConsumerConfigs.TimeSource.
+var TimeSourceAllowed = []string{
+	TimeSourceKafkaTimestamp,
+	TimeSourceHeader,
+	TimeSourceNow,
+}
+
+// DeliveryProxySchemesAllowed are the allowed schemes of ConsumerConfigs.DeliveryProxyURL.
+var DeliveryProxySchemesAllowed = []string{"http", "https", "socks5"}
+
 // ConsumerTemplateSpec describes the data a consumer should have when created from a template.
 type ConsumerTemplateSpec struct {
 
@@ -216,9 +945,74 @@ type ConsumerStatus struct {
 	// +optional
 	SubscriberAudience *string `json:"subscriberAudience,omitempty"`
 
+	// FlowControlWebhookURI is the resolved URI of the flow control webhook.
+	// +optional
+	FlowControlWebhookURI *apis.URL `json:"flowControlWebhookUri,omitempty"`
+
 	// DeliveryStatus contains a resolved URL to the dead letter sink address, and any other
 	// resolved delivery options.
 	eventingduck.DeliveryStatus `json:",inline"`
+
+	// DeadLetterTopic is the topic the dead-lettered events are produced to, when the dead letter
+	// sink is a KafkaSink, alongside DeadLetterSinkURI which is the address of the KafkaSink.
+	// +optional
+	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
+
+	// PartitionExpansions records the partition expansions performed on the topics.
+	// +optional
+	PartitionExpansions []PartitionExpansion `json:"partitionExpansions,omitempty"`
+
+	// VReplicas is the number of virtual replicas last applied to the contract.
+	// +optional
+	VReplicas *int32 `json:"vReplicas,omitempty"`
+
+	// ExpectedThroughput is the expected number of events per second declared in the spec.
+	// +optional
+	ExpectedThroughput *int32 `json:"expectedThroughput,omitempty"`
+
+	// EffectiveBootstrapServers are the bootstrap servers used by the dispatcher,
+	// with the credentials removed.
+	// +optional
+	EffectiveBootstrapServers string `json:"effectiveBootstrapServers,omitempty"`
+
+	// BoundPodUID is the UID of the pod the contract was last written for, it tells apart a pod
+	// recreated with the same name from the pod the Consumer was bound to.
+	// +optional
+	BoundPodUID types.UID `json:"boundPodUid,omitempty"`
+
+	// BoundAt is the time the Consumer was first bound, it isn't changed when the Consumer is bound
+	// again, for example after its pod is recreated.
+	// +optional
+	BoundAt *metav1.Time `json:"boundAt,omitempty"`
+
+	// EffectiveConcurrency is the delivery concurrency applied by the dispatcher, including
+	// the dispatcher defaults for the values unset in the spec.
+	// +optional
+	EffectiveConcurrency *EffectiveConcurrency `json:"effectiveConcurrency,omitempty"`
+}
+
+// EffectiveConcurrency is the delivery concurrency applied by the dispatcher.
+type EffectiveConcurrency struct {
+	// MaxInFlight is the maximum number of events being delivered at the same time, it's the
+	// max.poll.records consumer config.
+	MaxInFlight int32 `json:"maxInFlight"`
+
+	// FetchConcurrency is the maximum number of partitions the dispatcher fetches from in parallel,
+	// when unset in the spec it's the number of partitions of the Consumer topics, since the
+	// dispatcher fetches from every assigned partition in parallel.
+	FetchConcurrency int32 `json:"fetchConcurrency"`
+}
+
+// PartitionExpansion records the expansion of the partitions of a topic.
+type PartitionExpansion struct {
+	// Topic is the expanded topic.
+	Topic string `json:"topic"`
+
+	// FromPartitions is the number of partitions before the expansion.
+	FromPartitions int32 `json:"fromPartitions"`
+
+	// ToPartitions is the number of partitions after the expansion.
+	ToPartitions int32 `json:"toPartitions"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -18,13 +18,34 @@ package v1alpha1
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	eventingv1alpha1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/eventing/v1alpha1"
 	sources "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1"
 )
 
+// overridableFeatureFlags are the feature flags that can be overridden by a Consumer.
+var overridableFeatureFlags = []string{
+	config.DispatcherRateLimiterFlag,
+	config.DispatcherOrderedExecutorMetricsFlag,
+	feature.EvenTypeAutoCreate,
+}
+
 func (c *Consumer) Validate(ctx context.Context) *apis.FieldError {
 	specCtx := ctx
 	if apis.IsInUpdate(ctx) {
@@ -47,9 +68,161 @@ func (cs *ConsumerSpec) Validate(ctx context.Context) *apis.FieldError {
 		cs.Filters.Validate(ctx).ViaField("filters"),
 		cs.Subscriber.Validate(ctx).ViaField("subscriber"),
 		cs.PodBind.Validate(ctx).ViaField("podBind"),
-		cs.CloudEventOverrides.Validate(ctx).ViaField("ceOverrides"),
+		sources.ValidateCloudEventOverrides(cs.CloudEventOverrides).ViaField("ceOverrides"),
 		cs.Reply.Validate(ctx).ViaField("reply"),
+		cs.Auth.Validate(ctx).ViaField("auth"),
+		cs.RebalanceHooks.Validate(ctx).ViaField("rebalanceHooks"),
+		cs.Audit.Validate(ctx).ViaField("audit"),
+		validateFeatureFlagOverrides(cs.FeatureFlagOverrides).ViaField("featureFlagOverrides"),
+		validateDeliveryAttempts(cs.Delivery, cs.CloudEventOverrides),
+		validateOrderingFallback(cs.Delivery, cs.Configs),
+		validateOrderingKeyHeader(cs.Delivery, cs.Configs),
+		validateCommitInterval(cs.Delivery, cs.Configs),
+		validateRequiredExtensions(cs.RequiredExtensions, cs.OnMissingExtensions),
 	)
+	if cs.SubscriberClientCertSecretRef != nil && cs.SubscriberClientCertSecretRef.Name == "" {
+		err = err.Also(apis.ErrMissingField("subscriberClientCertSecretRef.name"))
+	}
+	if cs.TopicPartitions != nil && *cs.TopicPartitions <= 0 {
+		err = err.Also(apis.ErrInvalidValue(*cs.TopicPartitions, "topicPartitions"))
+	}
+	if cs.ExpectedThroughput != nil && *cs.ExpectedThroughput <= 0 {
+		err = err.Also(apis.ErrInvalidValue(*cs.ExpectedThroughput, "expectedThroughput", "expected a positive value"))
+	}
+	if cs.FetchConcurrency != nil && *cs.FetchConcurrency <= 0 {
+		err = err.Also(apis.ErrInvalidValue(*cs.FetchConcurrency, "fetchConcurrency", "expected a positive value"))
+	}
+	if cs.ShadowSubscriber != nil {
+		err = err.Also(cs.ShadowSubscriber.Validate(ctx).ViaField("shadowSubscriber"))
+	}
+	if cs.ShadowSamplePercent != nil && (*cs.ShadowSamplePercent < 0 || *cs.ShadowSamplePercent > 100) {
+		err = err.Also(apis.ErrOutOfBoundsValue(*cs.ShadowSamplePercent, 0, 100, "shadowSamplePercent"))
+	}
+	if cs.FinalizeDrainTimeout != nil && cs.FinalizeDrainTimeout.Duration <= 0 {
+		err = err.Also(apis.ErrInvalidValue(cs.FinalizeDrainTimeout.Duration.String(), "finalizeDrainTimeout", "expected a positive duration"))
+	}
+	return err.Also(validateTopicRoutes(ctx, cs.Topics, cs.TopicRoutes))
+}
+
+// validateOrderingFallback rejects an ordering fallback when the delivery isn't ordered,
+// since it'd have no effect.
+func validateOrderingFallback(delivery *DeliverySpec, configs ConsumerConfigs) *apis.FieldError {
+	if configs.OrderingFallback == nil || (delivery != nil && delivery.Ordering == sources.Ordered) {
+		return nil
+	}
+	return apis.ErrGeneric("expected delivery.ordering to be "+string(sources.Ordered), "configs.orderingFallback")
+}
+
+// validateOrderingKeyHeader rejects an ordering key header when the delivery isn't ordered,
+// since it'd have no effect.
+func validateOrderingKeyHeader(delivery *DeliverySpec, configs ConsumerConfigs) *apis.FieldError {
+	if configs.OrderingKeyHeader == nil || (delivery != nil && delivery.Ordering == sources.Ordered) {
+		return nil
+	}
+	return apis.ErrGeneric("expected delivery.ordering to be "+string(sources.Ordered), "configs.orderingKeyHeader")
+}
+
+// validateCommitInterval rejects a commit interval when the offsets are committed as soon as
+// the events are read, since they aren't committed periodically.
+func validateCommitInterval(delivery *DeliverySpec, configs ConsumerConfigs) *apis.FieldError {
+	if configs.CommitInterval == nil || delivery == nil || delivery.CommitMode == nil || *delivery.CommitMode != CommitModeOnReceipt {
+		return nil
+	}
+	return apis.ErrGeneric("expected delivery.commitMode to be "+string(CommitModeOnSuccess), "configs.commitInterval")
+}
+
+// validateRequiredExtensions validates the required extension names and the policy applied
+// to the events missing them.
+func validateRequiredExtensions(names []string, policy *MissingExtensionsPolicy) *apis.FieldError {
+	var err *apis.FieldError
+	for i, name := range names {
+		if !sources.IsValidExtensionName(name) {
+			err = err.Also(apis.ErrInvalidArrayValue(name, "requiredExtensions", i))
+		}
+	}
+	if policy != nil {
+		switch *policy {
+		case MissingExtensionsDeadLetter, MissingExtensionsDrop:
+		default:
+			err = err.Also(apis.ErrInvalidValue(*policy, "onMissingExtensions",
+				fmt.Sprintf("allowed values: %v", []MissingExtensionsPolicy{MissingExtensionsDeadLetter, MissingExtensionsDrop})))
+		}
+	}
+	return err
+}
+
+// validateDeliveryAttempts rejects CloudEvent overrides of the delivery attempts extension
+// when the dispatcher sets it.
+func validateDeliveryAttempts(delivery *DeliverySpec, overrides *duckv1.CloudEventOverrides) *apis.FieldError {
+	if delivery == nil || !delivery.EmitDeliveryAttempts || overrides == nil {
+		return nil
+	}
+	if _, ok := overrides.Extensions[DeliveryAttemptsExtension]; ok {
+		return apis.ErrInvalidKeyName(DeliveryAttemptsExtension, "ceOverrides.extensions",
+			"the extension is reserved when delivery.emitDeliveryAttempts is enabled")
+	}
+	return nil
+}
+
+func isValidUserAgent(ua string) bool {
+	if strings.TrimSpace(ua) == "" {
+		return false
+	}
+	for _, r := range ua {
+		if r < ' ' || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidTopicName reports whether name is a legal Kafka topic name.
+func isValidTopicName(name string) bool {
+	if name == "" || name == "." || name == ".." || len(name) > 249 {
+		return false
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '.' && r != '_' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+func validateTopicRoutes(ctx context.Context, topics []string, routes []TopicRoute) *apis.FieldError {
+	var err *apis.FieldError
+	known := sets.New(topics...)
+	routed := sets.New[string]()
+	for i, route := range routes {
+		if !known.Has(route.Topic) {
+			err = err.Also(apis.ErrInvalidValue(route.Topic, "topic", "topic is not one of the Consumer topics").ViaFieldIndex("topicRoutes", i))
+		} else if routed.Has(route.Topic) {
+			err = err.Also(apis.ErrInvalidValue(route.Topic, "topic", "topic is routed more than once").ViaFieldIndex("topicRoutes", i))
+		}
+		routed.Insert(route.Topic)
+		err = err.Also(route.Subscriber.Validate(ctx).ViaField("subscriber").ViaFieldIndex("topicRoutes", i))
+	}
+	return err
+}
+
+func validateFeatureFlagOverrides(overrides map[string]string) *apis.FieldError {
+	var err *apis.FieldError
+	for k, v := range overrides {
+		known := false
+		for _, flag := range overridableFeatureFlags {
+			if flag == k {
+				known = true
+				break
+			}
+		}
+		if !known {
+			err = err.Also(apis.ErrInvalidKeyName(k, apis.CurrentField, fmt.Sprintf("allowed flags: %v", overridableFeatureFlags)))
+			continue
+		}
+		if !strings.EqualFold(v, string(feature.Enabled)) && !strings.EqualFold(v, string(feature.Disabled)) {
+			err = err.Also(apis.ErrInvalidValue(v, apis.CurrentField, fmt.Sprintf("allowed values: %v", []feature.Flag{feature.Enabled, feature.Disabled})).ViaKey(k))
+		}
+	}
 	return err
 }
 
@@ -57,7 +230,94 @@ func (d *DeliverySpec) Validate(ctx context.Context) *apis.FieldError {
 	if d == nil {
 		return nil
 	}
-	return d.DeliverySpec.Validate(ctx).ViaField(apis.CurrentField)
+	err := d.DeliverySpec.Validate(ctx).ViaField(apis.CurrentField)
+	if d.RetryDeadline != nil && d.RetryDeadline.Duration <= 0 {
+		err = err.Also(apis.ErrInvalidValue(d.RetryDeadline.Duration.String(), "retryDeadline", "expected a positive duration"))
+	}
+	if d.MaxRetryDuration != nil && d.MaxRetryDuration.Duration <= 0 {
+		err = err.Also(apis.ErrInvalidValue(d.MaxRetryDuration.Duration.String(), "maxRetryDuration", "expected a positive duration"))
+	}
+	if d.DedupWindow != nil && d.DedupWindow.Duration < 0 {
+		err = err.Also(apis.ErrInvalidValue(d.DedupWindow.Duration.String(), "dedupWindow", "expected a non-negative duration"))
+	}
+	if d.StallWindow != nil && d.StallWindow.Duration <= 0 {
+		err = err.Also(apis.ErrInvalidValue(d.StallWindow.Duration.String(), "stallWindow", "expected a positive duration"))
+	}
+	if d.UserAgent != nil && !isValidUserAgent(*d.UserAgent) {
+		err = err.Also(apis.ErrInvalidValue(*d.UserAgent, "userAgent", "expected a non-empty value without control characters"))
+	}
+	if d.DeadLetterReasonExtension != nil && !sources.IsValidExtensionName(*d.DeadLetterReasonExtension) {
+		err = err.Also(apis.ErrInvalidValue(*d.DeadLetterReasonExtension, "deadLetterReasonExtension", "expected a CloudEvent extension name made of lowercase letters and digits"))
+	}
+	if d.PayloadFormat != nil {
+		switch *d.PayloadFormat {
+		case PayloadFormatCloudEvent, PayloadFormatRaw:
+		default:
+			err = err.Also(apis.ErrInvalidValue(*d.PayloadFormat, "payloadFormat",
+				fmt.Sprintf("allowed values: %v", []PayloadFormat{PayloadFormatCloudEvent, PayloadFormatRaw})))
+		}
+	}
+	if d.DeadLetterContentMode != nil && *d.DeadLetterContentMode != eventingv1alpha1.ModeBinary && *d.DeadLetterContentMode != eventingv1alpha1.ModeStructured {
+		err = err.Also(apis.ErrInvalidValue(*d.DeadLetterContentMode, "deadLetterContentMode",
+			fmt.Sprintf("allowed values: %v", []string{eventingv1alpha1.ModeBinary, eventingv1alpha1.ModeStructured})))
+	}
+	if d.DeadLetterCACerts != nil && !x509.NewCertPool().AppendCertsFromPEM([]byte(*d.DeadLetterCACerts)) {
+		err = err.Also(apis.ErrGeneric("expected PEM encoded certificates", "deadLetterCACerts"))
+	}
+	if d.OversizeHandling != nil {
+		switch *d.OversizeHandling {
+		case OversizeHandlingDeadLetter, OversizeHandlingSkip, OversizeHandlingTruncate:
+		default:
+			err = err.Also(apis.ErrInvalidValue(*d.OversizeHandling, "oversizeHandling",
+				fmt.Sprintf("allowed values: %v", []OversizeHandling{OversizeHandlingDeadLetter, OversizeHandlingSkip, OversizeHandlingTruncate})))
+		}
+		if d.OversizeThresholdBytes == nil {
+			err = err.Also(apis.ErrMissingField("oversizeThresholdBytes"))
+		}
+	}
+	if d.CommitMode != nil {
+		switch *d.CommitMode {
+		case CommitModeOnSuccess, CommitModeOnReceipt:
+		default:
+			err = err.Also(apis.ErrInvalidValue(*d.CommitMode, "commitMode",
+				fmt.Sprintf("allowed values: %v", []CommitMode{CommitModeOnSuccess, CommitModeOnReceipt})))
+		}
+	}
+	if d.Signing != nil {
+		err = err.Also(d.Signing.Validate().ViaField("signing"))
+	}
+	if d.OversizeThresholdBytes != nil && *d.OversizeThresholdBytes <= 0 {
+		err = err.Also(apis.ErrInvalidValue(*d.OversizeThresholdBytes, "oversizeThresholdBytes", "expected a positive integer"))
+	}
+	if d.MaxAttempts != nil && *d.MaxAttempts <= 0 {
+		err = err.Also(apis.ErrInvalidValue(*d.MaxAttempts, "maxAttempts", "expected a positive integer"))
+	}
+	if d.CircuitBreaker != nil {
+		if d.CircuitBreaker.FailureThreshold <= 0 {
+			err = err.Also(apis.ErrInvalidValue(d.CircuitBreaker.FailureThreshold, "circuitBreaker.failureThreshold", "expected a positive integer"))
+		}
+		if d.CircuitBreaker.PauseDuration.Duration <= 0 {
+			err = err.Also(apis.ErrInvalidValue(d.CircuitBreaker.PauseDuration.Duration.String(), "circuitBreaker.pauseDuration", "expected a positive duration"))
+		}
+	}
+	return err
+}
+
+func (s *DeliverySigning) Validate() *apis.FieldError {
+	var err *apis.FieldError
+	if s.SecretName == "" {
+		err = err.Also(apis.ErrMissingField("secretName"))
+	}
+	if s.SecretKey == "" {
+		err = err.Also(apis.ErrMissingField("secretKey"))
+	}
+	switch s.Algorithm {
+	case SigningAlgorithmHMACSHA256, SigningAlgorithmHMACSHA512:
+	default:
+		err = err.Also(apis.ErrInvalidValue(s.Algorithm, "algorithm",
+			fmt.Sprintf("allowed values: %v", []SigningAlgorithm{SigningAlgorithmHMACSHA256, SigningAlgorithmHMACSHA512})))
+	}
+	return err
 }
 
 func (cc *ConsumerConfigs) Validate(ctx context.Context) *apis.FieldError {
@@ -71,6 +331,36 @@ func (cc *ConsumerConfigs) Validate(ctx context.Context) *apis.FieldError {
 		}
 	}
 
+	if v, ok := cc.Configs["allow.auto.create.topics"]; ok {
+		if _, err := strconv.ParseBool(v); err != nil {
+			return apis.ErrInvalidValue(v, "allow.auto.create.topics", "expected a boolean value")
+		}
+	}
+
+	if v, ok := cc.Configs["enable.auto.commit"]; ok {
+		if _, err := strconv.ParseBool(v); err != nil {
+			return apis.ErrInvalidValue(v, "enable.auto.commit", "expected a boolean value")
+		}
+	}
+
+	if v, ok := cc.Configs["client.rack"]; ok {
+		if v == "" || strings.ContainsFunc(v, unicode.IsSpace) {
+			return apis.ErrInvalidValue(v, "client.rack", "expected a non-empty value without whitespaces")
+		}
+	}
+
+	if v, ok := cc.Configs["metadata.max.age.ms"]; ok {
+		if maxAge, err := strconv.ParseInt(v, 10, 64); err != nil || maxAge <= 0 {
+			return apis.ErrInvalidValue(v, "metadata.max.age.ms", "expected a positive integer")
+		}
+	}
+
+	if v, ok := cc.Configs[CoordinatorDiscoveryTimeoutConfig]; ok {
+		if timeout, err := strconv.ParseInt(v, 10, 64); err != nil || timeout <= 0 {
+			return apis.ErrInvalidValue(v, CoordinatorDiscoveryTimeoutConfig, "expected a positive integer")
+		}
+	}
+
 	if cc.KeyType != nil {
 		found := false
 		for _, allowed := range sources.KafkaKeyTypeAllowed {
@@ -84,9 +374,197 @@ func (cc *ConsumerConfigs) Validate(ctx context.Context) *apis.FieldError {
 		}
 	}
 
+	if cc.ReconnectBackoff != nil && cc.ReconnectBackoff.Duration <= 0 {
+		return apis.ErrInvalidValue(cc.ReconnectBackoff.Duration.String(), "reconnectBackoff", "expected a positive duration")
+	}
+	if cc.ReconnectBackoffMax != nil && cc.ReconnectBackoffMax.Duration <= 0 {
+		return apis.ErrInvalidValue(cc.ReconnectBackoffMax.Duration.String(), "reconnectBackoffMax", "expected a positive duration")
+	}
+	if cc.ReconnectBackoff != nil && cc.ReconnectBackoffMax != nil && cc.ReconnectBackoffMax.Duration < cc.ReconnectBackoff.Duration {
+		return apis.ErrInvalidValue(cc.ReconnectBackoffMax.Duration.String(), "reconnectBackoffMax", "expected a duration greater than or equal to reconnectBackoff")
+	}
+
+	if cc.CommitInterval != nil {
+		if cc.CommitInterval.Duration <= 0 {
+			return apis.ErrInvalidValue(cc.CommitInterval.Duration.String(), "commitInterval", "expected a positive duration")
+		}
+		if _, ok := cc.Configs["auto.commit.interval.ms"]; ok {
+			return apis.ErrMultipleOneOf("commitInterval", "auto.commit.interval.ms")
+		}
+		if v, ok := cc.Configs["enable.auto.commit"]; ok {
+			if enable, _ := strconv.ParseBool(v); !enable {
+				return apis.ErrGeneric("expected enable.auto.commit to be true", "commitInterval")
+			}
+		}
+	}
+
+	if cc.FetchMaxWait != nil {
+		if cc.FetchMaxWait.Duration < 0 {
+			return apis.ErrInvalidValue(cc.FetchMaxWait.Duration.String(), "fetchMaxWait", "expected a non-negative duration")
+		}
+		if _, ok := cc.Configs["fetch.max.wait.ms"]; ok {
+			return apis.ErrMultipleOneOf("fetchMaxWait", "fetch.max.wait.ms")
+		}
+	}
+
+	if cc.ShutdownGrace != nil && cc.ShutdownGrace.Duration < 0 {
+		return apis.ErrInvalidValue(cc.ShutdownGrace.Duration.String(), "shutdownGrace", "expected a non-negative duration")
+	}
+	if cc.RequestTimeout != nil && cc.RequestTimeout.Duration <= 0 {
+		return apis.ErrInvalidValue(cc.RequestTimeout.Duration.String(), "requestTimeout", "expected a positive duration")
+	}
+
+	if cc.FetchMaxBytes != nil && *cc.FetchMaxBytes <= 0 {
+		return apis.ErrInvalidValue(*cc.FetchMaxBytes, "fetchMaxBytes", "expected a positive value")
+	}
+	if cc.ReceiveBufferBytes != nil && *cc.ReceiveBufferBytes <= 0 {
+		return apis.ErrInvalidValue(*cc.ReceiveBufferBytes, "receiveBufferBytes", "expected a positive value")
+	}
+	if cc.LagBasedScaleTarget != nil && *cc.LagBasedScaleTarget <= 0 {
+		return apis.ErrInvalidValue(*cc.LagBasedScaleTarget, "lagBasedScaleTarget", "expected a positive value")
+	}
+	if cc.MaxConsecutiveDeserFailures != nil && *cc.MaxConsecutiveDeserFailures <= 0 {
+		return apis.ErrInvalidValue(*cc.MaxConsecutiveDeserFailures, "maxConsecutiveDeserFailures", "expected a positive value")
+	}
+
+	if cc.KeepAlive != nil && cc.KeepAlive.Duration < 0 {
+		return apis.ErrInvalidValue(cc.KeepAlive.Duration.String(), "keepAlive", "expected a non-negative duration")
+	}
+	if cc.SubscriberMaxConns != nil && *cc.SubscriberMaxConns < 1 {
+		return apis.ErrInvalidValue(*cc.SubscriberMaxConns, "subscriberMaxConns", "expected a value greater than or equal to 1")
+	}
+
+	if cc.SubscriberMaxIdleConns != nil && *cc.SubscriberMaxIdleConns <= 0 {
+		return apis.ErrInvalidValue(*cc.SubscriberMaxIdleConns, "subscriberMaxIdleConns", "expected a positive value")
+	}
+
+	if cc.ContentMode != nil && *cc.ContentMode != eventingv1alpha1.ModeBinary && *cc.ContentMode != eventingv1alpha1.ModeStructured {
+		return apis.ErrInvalidValue(*cc.ContentMode, "contentMode",
+			fmt.Sprintf("allowed values: %v", []string{eventingv1alpha1.ModeBinary, eventingv1alpha1.ModeStructured}))
+	}
+
+	if cc.PartitionAssignor != nil && !slices.Contains(PartitionAssignorAllowed, *cc.PartitionAssignor) {
+		return apis.ErrInvalidValue(*cc.PartitionAssignor, "partitionAssignor", fmt.Sprintf("allowed values: %v", PartitionAssignorAllowed))
+	}
+
+	if cc.NullKeyPolicy != nil && !slices.Contains(NullKeyPolicyAllowed, *cc.NullKeyPolicy) {
+		return apis.ErrInvalidValue(*cc.NullKeyPolicy, "nullKeyPolicy", fmt.Sprintf("allowed values: %v", NullKeyPolicyAllowed))
+	}
+
+	if cc.IdGenerationStrategy != nil && !slices.Contains(IdGenerationStrategyAllowed, *cc.IdGenerationStrategy) {
+		return apis.ErrInvalidValue(*cc.IdGenerationStrategy, "idGenerationStrategy", fmt.Sprintf("allowed values: %v", IdGenerationStrategyAllowed))
+	}
+
+	if cc.OnTopicDeleted != nil && !slices.Contains(OnTopicDeletedAllowed, *cc.OnTopicDeleted) {
+		return apis.ErrInvalidValue(*cc.OnTopicDeleted, "onTopicDeleted", fmt.Sprintf("allowed values: %v", OnTopicDeletedAllowed))
+	}
+
+	if cc.OnAuthorizationFailure != nil && !slices.Contains(OnAuthorizationFailureAllowed, *cc.OnAuthorizationFailure) {
+		return apis.ErrInvalidValue(*cc.OnAuthorizationFailure, "onAuthorizationFailure", fmt.Sprintf("allowed values: %v", OnAuthorizationFailureAllowed))
+	}
+
+	if cc.MetricsSampleRate != nil && (*cc.MetricsSampleRate < 0 || *cc.MetricsSampleRate > 100) {
+		return apis.ErrOutOfBoundsValue(*cc.MetricsSampleRate, 0, 100, "metricsSampleRate")
+	}
+
+	if cc.OrderingFallback != nil && !slices.Contains(OrderingFallbackAllowed, *cc.OrderingFallback) {
+		return apis.ErrInvalidValue(*cc.OrderingFallback, "orderingFallback", fmt.Sprintf("allowed values: %v", OrderingFallbackAllowed))
+	}
+
+	if cc.OrderingKeyHeader != nil {
+		if errs := validation.IsHTTPHeaderName(*cc.OrderingKeyHeader); len(errs) > 0 {
+			return apis.ErrInvalidValue(*cc.OrderingKeyHeader, "orderingKeyHeader", strings.Join(errs, ", "))
+		}
+	}
+
+	if cc.OnTombstone != nil && !slices.Contains(OnTombstoneAllowed, *cc.OnTombstone) {
+		return apis.ErrInvalidValue(*cc.OnTombstone, "onTombstone", fmt.Sprintf("allowed values: %v", OnTombstoneAllowed))
+	}
+
+	if cc.RebalanceTimeout != nil && cc.RebalanceTimeout.Duration <= 0 {
+		return apis.ErrInvalidValue(cc.RebalanceTimeout.Duration.String(), "rebalanceTimeout", "expected a positive duration")
+	}
+
+	if cc.TimeSource != nil && !slices.Contains(TimeSourceAllowed, *cc.TimeSource) {
+		return apis.ErrInvalidValue(*cc.TimeSource, "timeSource", fmt.Sprintf("allowed values: %v", TimeSourceAllowed))
+	}
+
+	if cc.TimeSource != nil && *cc.TimeSource == TimeSourceHeader {
+		if cc.TimeSourceHeader == nil {
+			return apis.ErrMissingField("timeSourceHeader")
+		}
+		if errs := validation.IsHTTPHeaderName(*cc.TimeSourceHeader); len(errs) > 0 {
+			return apis.ErrInvalidValue(*cc.TimeSourceHeader, "timeSourceHeader", strings.Join(errs, ", "))
+		}
+	} else if cc.TimeSourceHeader != nil {
+		return apis.ErrDisallowedFields("timeSourceHeader")
+	}
+
+	if cc.AdditionalGroupID != nil && (*cc.AdditionalGroupID == "" || *cc.AdditionalGroupID == cc.Configs["group.id"]) {
+		return apis.ErrInvalidValue(*cc.AdditionalGroupID, "additionalGroupId", "expected a non-empty value different from group.id")
+	}
+
+	if cc.FlowControlWebhook != nil {
+		if err := cc.FlowControlWebhook.Validate(ctx); err != nil {
+			return err.ViaField("flowControlWebhook")
+		}
+	}
+
+	if cc.DeliveryProxyURL != nil {
+		u, err := url.Parse(*cc.DeliveryProxyURL)
+		if err != nil || !slices.Contains(DeliveryProxySchemesAllowed, u.Scheme) || u.Host == "" {
+			return apis.ErrInvalidValue(*cc.DeliveryProxyURL, "deliveryProxyURL",
+				fmt.Sprintf("expected a proxy URL with scheme in %v and a host", DeliveryProxySchemesAllowed))
+		}
+	}
+
+	for i, h := range cc.StripHeaders {
+		if errs := validation.IsHTTPHeaderName(h); len(errs) > 0 {
+			return apis.ErrInvalidArrayValue(h, "stripHeaders", i)
+		}
+	}
+
 	return nil
 }
 
+func (a *Auth) Validate(ctx context.Context) *apis.FieldError {
+	if a == nil {
+		return nil
+	}
+	return a.TLS.Validate(ctx).ViaField("tls")
+}
+
+func (t *TLSSpec) Validate(ctx context.Context) *apis.FieldError {
+	if t == nil {
+		return nil
+	}
+	if t.ServerName != nil {
+		if errs := validation.IsDNS1123Subdomain(*t.ServerName); len(errs) > 0 {
+			return apis.ErrInvalidValue(*t.ServerName, "serverName", strings.Join(errs, ", "))
+		}
+	}
+	if t.MinVersion != nil && !slices.Contains(TLSVersionAllowed, *t.MinVersion) {
+		return apis.ErrInvalidValue(*t.MinVersion, "minVersion", fmt.Sprintf("allowed values: %v", TLSVersionAllowed))
+	}
+	var err *apis.FieldError
+	for i, name := range t.CipherSuites {
+		if !isSecureCipherSuite(name) {
+			err = err.Also(apis.ErrInvalidArrayValue(name, "cipherSuites", i))
+		}
+	}
+	return err
+}
+
+// isSecureCipherSuite reports whether name is the IANA name of a cipher suite without known security issues.
+func isSecureCipherSuite(name string) bool {
+	for _, cs := range tls.CipherSuites() {
+		if cs.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *Filters) Validate(ctx context.Context) *apis.FieldError {
 	return nil
 }
@@ -109,9 +587,99 @@ func (in *ReplyStrategy) Validate(ctx context.Context) *apis.FieldError {
 	if setCounter > 1 {
 		return apis.ErrMultipleOneOf("topicReply", "URLReply", "NoReply")
 	}
+
+	if in.TopicReply != nil && in.TopicReply.KeyAttribute != nil && !sources.IsValidExtensionName(*in.TopicReply.KeyAttribute) {
+		return apis.ErrInvalidValue(*in.TopicReply.KeyAttribute, "topicReply.keyAttribute", "expected a CloudEvent attribute name made of lowercase letters and digits")
+	}
+
+	if in.TopicReply != nil && in.TopicReply.BootstrapServers != nil && !isValidBootstrapServers(*in.TopicReply.BootstrapServers) {
+		return apis.ErrInvalidValue(*in.TopicReply.BootstrapServers, "topicReply.bootstrapServers", "expected comma separated host:port addresses")
+	}
+
+	if in.TopicReply != nil && in.TopicReply.NetSpec != nil && in.TopicReply.BootstrapServers == nil {
+		return apis.ErrGeneric("netSpec requires bootstrapServers to be set", "topicReply.netSpec")
+	}
+
+	if in.TopicReply != nil && in.TopicReply.MaxProducers != nil {
+		if *in.TopicReply.MaxProducers < 1 {
+			return apis.ErrInvalidValue(*in.TopicReply.MaxProducers, "topicReply.maxProducers", "expected at least 1")
+		}
+		if !in.TopicReply.Enabled {
+			return apis.ErrGeneric("maxProducers requires topicReply to be enabled", "topicReply.maxProducers")
+		}
+	}
+
+	if in.OnMalformed != nil {
+		switch *in.OnMalformed {
+		case MalformedReplyDrop, MalformedReplyDeadLetter, MalformedReplyError:
+		default:
+			return apis.ErrInvalidValue(*in.OnMalformed, "onMalformed",
+				fmt.Sprintf("allowed values: %v", []MalformedReplyPolicy{MalformedReplyDrop, MalformedReplyDeadLetter, MalformedReplyError}))
+		}
+		if (in.TopicReply == nil || !in.TopicReply.Enabled) && (in.URLReply == nil || !in.URLReply.Enabled) {
+			return apis.ErrGeneric("onMalformed requires topicReply or URLReply to be enabled", "onMalformed")
+		}
+	}
 	return nil
 }
 
+// isValidBootstrapServers returns whether the given comma separated bootstrap servers are host:port
+// addresses, optionally prefixed by the security protocol, like `SASL_SSL://broker:9092`.
+func isValidBootstrapServers(bootstrapServers string) bool {
+	for _, server := range strings.Split(bootstrapServers, ",") {
+		server = strings.TrimSpace(server)
+		if i := strings.Index(server, "://"); i >= 0 {
+			server = server[i+len("://"):]
+		}
+		host, port, err := net.SplitHostPort(server)
+		if err != nil || host == "" {
+			return false
+		}
+		if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 65535 {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *Audit) Validate(ctx context.Context) *apis.FieldError {
+	if a == nil {
+		return nil
+	}
+	var err *apis.FieldError
+	if a.Topic == "" {
+		err = err.Also(apis.ErrMissingField("topic"))
+	} else if !isValidTopicName(a.Topic) {
+		err = err.Also(apis.ErrInvalidValue(a.Topic, "topic", "expected a Kafka topic name made of at most 249 letters, digits, '.', '_' or '-'"))
+	}
+	if a.Level != nil {
+		switch *a.Level {
+		case AuditLevelAll, AuditLevelFailures:
+		default:
+			err = err.Also(apis.ErrInvalidValue(*a.Level, "level",
+				fmt.Sprintf("allowed values: %v", []AuditLevel{AuditLevelAll, AuditLevelFailures})))
+		}
+	}
+	return err
+}
+
+func (h *RebalanceHooks) Validate(ctx context.Context) *apis.FieldError {
+	if h == nil {
+		return nil
+	}
+	if h.OnRevoke == nil && h.OnAssign == nil {
+		return apis.ErrMissingOneOf("onRevoke", "onAssign")
+	}
+	var err *apis.FieldError
+	if h.OnRevoke != nil {
+		err = err.Also(h.OnRevoke.Validate(ctx).ViaField("onRevoke"))
+	}
+	if h.OnAssign != nil {
+		err = err.Also(h.OnAssign.Validate(ctx).ViaField("onAssign"))
+	}
+	return err
+}
+
 func (p *PodBind) Validate(ctx context.Context) *apis.FieldError {
 	if p == nil {
 		return apis.ErrMissingField("")
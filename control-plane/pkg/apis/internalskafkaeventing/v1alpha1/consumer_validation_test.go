@@ -18,11 +18,20 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/eventingtls/eventingtlstesting"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	pointer "knative.dev/pkg/ptr"
+
+	bindings "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/bindings/v1"
+	sources "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1"
 )
 
 func TestConsumer_Validate(t *testing.T) {
@@ -117,7 +126,7 @@ func TestConsumer_Validate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "invalid no pod name",
+			name: "invalid topic partitions",
 			ctx:  context.Background(),
 			given: &Consumer{
 				Spec: ConsumerSpec{
@@ -138,14 +147,16 @@ func TestConsumer_Validate(t *testing.T) {
 						},
 					},
 					PodBind: &PodBind{
+						PodName:      "p-0",
 						PodNamespace: "ns",
 					},
+					TopicPartitions: pointer.Int32(0),
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "invalid no pod namespace",
+			name: "subscriber client certificate without name",
 			ctx:  context.Background(),
 			given: &Consumer{
 				Spec: ConsumerSpec{
@@ -165,16 +176,19 @@ func TestConsumer_Validate(t *testing.T) {
 							Host:   "127.0.0.1",
 						},
 					},
+					SubscriberClientCertSecretRef: &corev1.LocalObjectReference{},
 					PodBind: &PodBind{
-						PodName: "p-0",
+						PodName:      "p-0",
+						PodNamespace: "ns",
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "valid - no updates",
-			ctx: apis.WithinUpdate(context.Background(), &Consumer{
+			name: "valid expected throughput",
+			ctx:  context.Background(),
+			given: &Consumer{
 				Spec: ConsumerSpec{
 					Topics: []string{"t1"},
 					Configs: ConsumerConfigs{
@@ -196,9 +210,14 @@ func TestConsumer_Validate(t *testing.T) {
 						PodName:      "p-0",
 						PodNamespace: "ns",
 					},
+					ExpectedThroughput: pointer.Int32(1000),
 				},
 			},
-			),
+			wantErr: false,
+		},
+		{
+			name: "invalid expected throughput",
+			ctx:  context.Background(),
 			given: &Consumer{
 				Spec: ConsumerSpec{
 					Topics: []string{"t1"},
@@ -221,13 +240,15 @@ func TestConsumer_Validate(t *testing.T) {
 						PodName:      "p-0",
 						PodNamespace: "ns",
 					},
+					ExpectedThroughput: pointer.Int32(0),
 				},
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "invalid pod name update",
-			ctx: apis.WithinUpdate(context.Background(), &Consumer{
+			name: "valid fetch concurrency",
+			ctx:  context.Background(),
+			given: &Consumer{
 				Spec: ConsumerSpec{
 					Topics: []string{"t1"},
 					Configs: ConsumerConfigs{
@@ -246,12 +267,17 @@ func TestConsumer_Validate(t *testing.T) {
 						},
 					},
 					PodBind: &PodBind{
-						PodName:      "p-1",
+						PodName:      "p-0",
 						PodNamespace: "ns",
 					},
+					FetchConcurrency: pointer.Int32(8),
 				},
 			},
-			),
+			wantErr: false,
+		},
+		{
+			name: "invalid fetch concurrency",
+			ctx:  context.Background(),
 			given: &Consumer{
 				Spec: ConsumerSpec{
 					Topics: []string{"t1"},
@@ -274,12 +300,204 @@ func TestConsumer_Validate(t *testing.T) {
 						PodName:      "p-0",
 						PodNamespace: "ns",
 					},
+					FetchConcurrency: pointer.Int32(0),
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "invalid pod name update",
+			name: "invalid finalize drain timeout",
+			ctx:  context.Background(),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns",
+					},
+					FinalizeDrainTimeout: &metav1.Duration{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid shadow subscriber",
+			ctx:  context.Background(),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns",
+					},
+					ShadowSubscriber: &duckv1.Destination{},
+				},
+			},
+			wantErr: true,
+		}, {
+			name: "valid shadow sample percent",
+			ctx:  context.Background(),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns",
+					},
+					ShadowSubscriber:    &duckv1.Destination{URI: &apis.URL{Scheme: "http", Host: "shadow"}},
+					ShadowSamplePercent: pointer.Int32(50),
+				},
+			},
+			wantErr: false,
+		}, {
+			name: "invalid shadow sample percent above 100",
+			ctx:  context.Background(),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns",
+					},
+					ShadowSubscriber:    &duckv1.Destination{URI: &apis.URL{Scheme: "http", Host: "shadow"}},
+					ShadowSamplePercent: pointer.Int32(101),
+				},
+			},
+			wantErr: true,
+		}, {
+			name: "invalid negative shadow sample percent",
+			ctx:  context.Background(),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns",
+					},
+					ShadowSubscriber:    &duckv1.Destination{URI: &apis.URL{Scheme: "http", Host: "shadow"}},
+					ShadowSamplePercent: pointer.Int32(-1),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid no pod name",
+			ctx:  context.Background(),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Delivery: &DeliverySpec{
+						DeliverySpec: &eventingduck.DeliverySpec{},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodNamespace: "ns",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid no pod namespace",
+			ctx:  context.Background(),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Delivery: &DeliverySpec{
+						DeliverySpec: &eventingduck.DeliverySpec{},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName: "p-0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid - no updates",
 			ctx: apis.WithinUpdate(context.Background(), &Consumer{
 				Spec: ConsumerSpec{
 					Topics: []string{"t1"},
@@ -300,7 +518,7 @@ func TestConsumer_Validate(t *testing.T) {
 					},
 					PodBind: &PodBind{
 						PodName:      "p-0",
-						PodNamespace: "ns-1",
+						PodNamespace: "ns",
 					},
 				},
 			},
@@ -325,10 +543,2179 @@ func TestConsumer_Validate(t *testing.T) {
 					},
 					PodBind: &PodBind{
 						PodName:      "p-0",
-						PodNamespace: "ns-2",
+						PodNamespace: "ns",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid pod name update",
+			ctx: apis.WithinUpdate(context.Background(), &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Delivery: &DeliverySpec{
+						DeliverySpec: &eventingduck.DeliverySpec{},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-1",
+						PodNamespace: "ns",
 					},
 				},
 			},
+			),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Delivery: &DeliverySpec{
+						DeliverySpec: &eventingduck.DeliverySpec{},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pod name update",
+			ctx: apis.WithinUpdate(context.Background(), &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Delivery: &DeliverySpec{
+						DeliverySpec: &eventingduck.DeliverySpec{},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns-1",
+					},
+				},
+			},
+			),
+			given: &Consumer{
+				Spec: ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: ConsumerConfigs{
+						Configs: map[string]string{
+							"group.id":          "g1",
+							"bootstrap.servers": "kafka:9092",
+						},
+					},
+					Delivery: &DeliverySpec{
+						DeliverySpec: &eventingduck.DeliverySpec{},
+					},
+					Subscriber: duckv1.Destination{
+						URI: &apis.URL{
+							Scheme: "http",
+							Host:   "127.0.0.1",
+						},
+					},
+					PodBind: &PodBind{
+						PodName:      "p-0",
+						PodNamespace: "ns-2",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.given.Validate(tt.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestConsumerConfigs_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		given   *ConsumerConfigs
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid allow.auto.create.topics",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":                 "g1",
+					"bootstrap.servers":        "kafka:9092",
+					"allow.auto.create.topics": "true",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid allow.auto.create.topics",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":                 "g1",
+					"bootstrap.servers":        "kafka:9092",
+					"allow.auto.create.topics": "yes please",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid enable.auto.commit",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":           "g1",
+					"bootstrap.servers":  "kafka:9092",
+					"enable.auto.commit": "false",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid enable.auto.commit",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":           "g1",
+					"bootstrap.servers":  "kafka:9092",
+					"enable.auto.commit": "never",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid client.rack",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+					"client.rack":       "us-east-1a",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid client.rack",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+					"client.rack":       "us east",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid metadata.max.age.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":            "g1",
+					"bootstrap.servers":   "kafka:9092",
+					"metadata.max.age.ms": "30000",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "non positive metadata.max.age.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":            "g1",
+					"bootstrap.servers":   "kafka:9092",
+					"metadata.max.age.ms": "0",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid metadata.max.age.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":            "g1",
+					"bootstrap.servers":   "kafka:9092",
+					"metadata.max.age.ms": "30s",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid coordinator.discovery.timeout.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":                         "g1",
+					"bootstrap.servers":                "kafka:9092",
+					"coordinator.discovery.timeout.ms": "60000",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "non positive coordinator.discovery.timeout.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":                         "g1",
+					"bootstrap.servers":                "kafka:9092",
+					"coordinator.discovery.timeout.ms": "-1",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid coordinator.discovery.timeout.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":                         "g1",
+					"bootstrap.servers":                "kafka:9092",
+					"coordinator.discovery.timeout.ms": "1m",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid strip headers",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				StripHeaders: []string{"X-Internal-Route", "kn-routing"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid strip header name",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				StripHeaders: []string{"X-Internal Route"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid commit interval",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				CommitInterval: &metav1.Duration{Duration: time.Second},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid non positive commit interval",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				CommitInterval: &metav1.Duration{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid commit interval with auto.commit.interval.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":                "g1",
+					"bootstrap.servers":       "kafka:9092",
+					"auto.commit.interval.ms": "1000",
+				},
+				CommitInterval: &metav1.Duration{Duration: time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid commit interval with auto commit enabled",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":           "g1",
+					"bootstrap.servers":  "kafka:9092",
+					"enable.auto.commit": "true",
+				},
+				CommitInterval: &metav1.Duration{Duration: time.Second},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid commit interval with auto commit disabled",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":           "g1",
+					"bootstrap.servers":  "kafka:9092",
+					"enable.auto.commit": "false",
+				},
+				CommitInterval: &metav1.Duration{Duration: time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid fetch max wait",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				FetchMaxWait: &metav1.Duration{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative fetch max wait",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				FetchMaxWait: &metav1.Duration{Duration: -time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid keep alive",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				KeepAlive: &metav1.Duration{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative keep alive",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				KeepAlive: &metav1.Duration{Duration: -time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid shutdown grace",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ShutdownGrace: &metav1.Duration{Duration: 30 * time.Second},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative shutdown grace",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ShutdownGrace: &metav1.Duration{Duration: -time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid rebalance timeout",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				RebalanceTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid zero rebalance timeout",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				RebalanceTimeout: &metav1.Duration{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid request timeout",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				RequestTimeout: &metav1.Duration{Duration: 5 * time.Second},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid zero request timeout",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				RequestTimeout: &metav1.Duration{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid delivery proxy URL",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				DeliveryProxyURL: pointer.String("socks5://proxy.corp:1080"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid delivery proxy URL",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				DeliveryProxyURL: pointer.String("ftp://proxy.corp"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid null key policy roundrobin",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				NullKeyPolicy: pointer.String("roundrobin"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid null key policy reject",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				NullKeyPolicy: pointer.String("reject"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid null key policy drop",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				NullKeyPolicy: pointer.String("drop"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid null key policy",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				NullKeyPolicy: pointer.String("ignore"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid id generation strategy uuid",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				IdGenerationStrategy: pointer.String("uuid"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid id generation strategy topic-partition-offset",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				IdGenerationStrategy: pointer.String("topic-partition-offset"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid id generation strategy",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				IdGenerationStrategy: pointer.String("sequential"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid recreate on topic deleted",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OnTopicDeleted: pointer.String("recreate"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid on topic deleted",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OnTopicDeleted: pointer.String("ignore"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid pause on authorization failure",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OnAuthorizationFailure: pointer.String("pause"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid on authorization failure",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OnAuthorizationFailure: pointer.String("ignore"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid metrics sample rate",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				MetricsSampleRate: pointer.Int32(50),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid zero metrics sample rate",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				MetricsSampleRate: pointer.Int32(0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid metrics sample rate above 100",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				MetricsSampleRate: pointer.Int32(101),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative metrics sample rate",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				MetricsSampleRate: pointer.Int32(-1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid ordering fallback",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OrderingFallback: pointer.String(OrderingFallbackError),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ordering fallback",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OrderingFallback: pointer.String("drop"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid ordering key header",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OrderingKeyHeader: pointer.String("x-ordering-key"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ordering key header",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OrderingKeyHeader: pointer.String("ordering key"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid additional group id",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				AdditionalGroupID: pointer.String("g2"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "additional group id equal to group id",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				AdditionalGroupID: pointer.String("g1"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty additional group id",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				AdditionalGroupID: pointer.String(""),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid flow control webhook",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				FlowControlWebhook: &duckv1.Destination{URI: &apis.URL{Scheme: "http", Host: "flow-control"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid flow control webhook",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				FlowControlWebhook: &duckv1.Destination{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid kafka timestamp time source",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				TimeSource: pointer.String(TimeSourceKafkaTimestamp),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid header time source",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				TimeSource:       pointer.String(TimeSourceHeader),
+				TimeSourceHeader: pointer.String("x-event-time"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid now time source",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				TimeSource: pointer.String(TimeSourceNow),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid time source",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				TimeSource: pointer.String("payload"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "header time source without header",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				TimeSource: pointer.String(TimeSourceHeader),
+			},
+			wantErr: true,
+		},
+		{
+			name: "header time source with invalid header",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				TimeSource:       pointer.String(TimeSourceHeader),
+				TimeSourceHeader: pointer.String("event time"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "time source header without header time source",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				TimeSource:       pointer.String(TimeSourceNow),
+				TimeSourceHeader: pointer.String("x-event-time"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid on tombstone",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OnTombstone: pointer.String(OnTombstoneSkip),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid on tombstone",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				OnTombstone: pointer.String("drop"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid max consecutive deserialization failures",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				MaxConsecutiveDeserFailures: pointer.Int32(10),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid max consecutive deserialization failures",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				MaxConsecutiveDeserFailures: pointer.Int32(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid fetch max wait with fetch.max.wait.ms",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+					"fetch.max.wait.ms": "100",
+				},
+				FetchMaxWait: &metav1.Duration{Duration: time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid reconnect backoff",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ReconnectBackoff:    &metav1.Duration{Duration: 100 * time.Millisecond},
+				ReconnectBackoffMax: &metav1.Duration{Duration: 10 * time.Second},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid reconnect backoff max lower than backoff",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ReconnectBackoff:    &metav1.Duration{Duration: 10 * time.Second},
+				ReconnectBackoffMax: &metav1.Duration{Duration: 100 * time.Millisecond},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid non positive reconnect backoff",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ReconnectBackoff: &metav1.Duration{Duration: 0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid buffer limits",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				FetchMaxBytes:      pointer.Int32(1048576),
+				ReceiveBufferBytes: pointer.Int32(65536),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid fetch max bytes",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				FetchMaxBytes: pointer.Int32(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid receive buffer bytes",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ReceiveBufferBytes: pointer.Int32(-1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid lag based scale target",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				LagBasedScaleTarget: pointer.Int32(100),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid lag based scale target",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				LagBasedScaleTarget: pointer.Int32(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid subscriber max conns",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				SubscriberMaxConns: pointer.Int32(1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid subscriber max conns below minimum",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				SubscriberMaxConns: pointer.Int32(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid subscriber max idle conns",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				SubscriberMaxIdleConns: pointer.Int32(8),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid zero subscriber max idle conns",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				SubscriberMaxIdleConns: pointer.Int32(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid binary content mode",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ContentMode: pointer.String("binary"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid structured content mode",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ContentMode: pointer.String("structured"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid content mode",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				ContentMode: pointer.String("batched"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid range partition assignor",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				PartitionAssignor: pointer.String("range"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid roundrobin partition assignor",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				PartitionAssignor: pointer.String("roundrobin"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid sticky partition assignor",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				PartitionAssignor: pointer.String("sticky"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid cooperative-sticky partition assignor",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				PartitionAssignor: pointer.String("cooperative-sticky"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid partition assignor",
+			ctx:  context.Background(),
+			given: &ConsumerConfigs{
+				Configs: map[string]string{
+					"group.id":          "g1",
+					"bootstrap.servers": "kafka:9092",
+				},
+				PartitionAssignor: pointer.String("uniform"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.given.Validate(tt.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDeliverySpec_Validate(t *testing.T) {
+	payloadFormat := func(f PayloadFormat) *PayloadFormat { return &f }
+	oversizeHandling := func(h OversizeHandling) *OversizeHandling { return &h }
+	commitMode := func(m CommitMode) *CommitMode { return &m }
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		given   *DeliverySpec
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			ctx:     context.Background(),
+			given:   &DeliverySpec{DeliverySpec: &eventingduck.DeliverySpec{}},
+			wantErr: false,
+		},
+		{
+			name: "valid retry deadline",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:  &eventingduck.DeliverySpec{},
+				RetryDeadline: &metav1.Duration{Duration: time.Minute},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid zero retry deadline",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:  &eventingduck.DeliverySpec{},
+				RetryDeadline: &metav1.Duration{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative retry deadline",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:  &eventingduck.DeliverySpec{},
+				RetryDeadline: &metav1.Duration{Duration: -time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid max retry duration",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:     &eventingduck.DeliverySpec{Retry: pointer.Int32(10)},
+				MaxRetryDuration: &metav1.Duration{Duration: time.Minute},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid zero max retry duration",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:     &eventingduck.DeliverySpec{},
+				MaxRetryDuration: &metav1.Duration{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative max retry duration",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:     &eventingduck.DeliverySpec{},
+				MaxRetryDuration: &metav1.Duration{Duration: -time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid stall window",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				StallWindow:  &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid zero stall window",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				StallWindow:  &metav1.Duration{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid signing",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				Signing:      &DeliverySigning{SecretName: "signing", SecretKey: "key", Algorithm: SigningAlgorithmHMACSHA512},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid signing algorithm",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				Signing:      &DeliverySigning{SecretName: "signing", SecretKey: "key", Algorithm: "md5"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid signing without secret",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				Signing:      &DeliverySigning{SecretKey: "key", Algorithm: SigningAlgorithmHMACSHA256},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid signing without secret key",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				Signing:      &DeliverySigning{SecretName: "signing", Algorithm: SigningAlgorithmHMACSHA256},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid user agent",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				UserAgent:    pointer.String("my-app/1.0 (team-a)"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid empty user agent",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				UserAgent:    pointer.String(" "),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid user agent with control characters",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				UserAgent:    pointer.String("my-app\r\nX-Injected: true"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid dead letter reason extension",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:              &eventingduck.DeliverySpec{},
+				DeadLetterReasonExtension: pointer.String("knativeerror"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid empty dead letter reason extension",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:              &eventingduck.DeliverySpec{},
+				DeadLetterReasonExtension: pointer.String(""),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid dead letter reason extension",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:              &eventingduck.DeliverySpec{},
+				DeadLetterReasonExtension: pointer.String("Error-Reason"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid raw payload format",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:  &eventingduck.DeliverySpec{},
+				PayloadFormat: payloadFormat(PayloadFormatRaw),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid payload format",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:  &eventingduck.DeliverySpec{},
+				PayloadFormat: payloadFormat("avro"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid commit mode",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				CommitMode:   commitMode(CommitModeOnReceipt),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid commit mode",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				CommitMode:   commitMode("on-dispatch"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid dead letter content mode",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:          &eventingduck.DeliverySpec{},
+				DeadLetterContentMode: pointer.String("structured"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid dead letter content mode",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:          &eventingduck.DeliverySpec{},
+				DeadLetterContentMode: pointer.String("batched"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid dedup window",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				DedupWindow:  &metav1.Duration{Duration: time.Minute},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative dedup window",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				DedupWindow:  &metav1.Duration{Duration: -time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid dead letter CA certs",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:      &eventingduck.DeliverySpec{},
+				DeadLetterCACerts: pointer.String(string(eventingtlstesting.CA)),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid dead letter CA certs",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:      &eventingduck.DeliverySpec{},
+				DeadLetterCACerts: pointer.String("not a certificate"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid oversize handling",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:           &eventingduck.DeliverySpec{},
+				OversizeHandling:       oversizeHandling(OversizeHandlingSkip),
+				OversizeThresholdBytes: pointer.Int32(1024),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid oversize handling",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:           &eventingduck.DeliverySpec{},
+				OversizeHandling:       oversizeHandling("ignore"),
+				OversizeThresholdBytes: pointer.Int32(1024),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid oversize handling without threshold",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:     &eventingduck.DeliverySpec{},
+				OversizeHandling: oversizeHandling(OversizeHandlingSkip),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid zero oversize threshold",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:           &eventingduck.DeliverySpec{},
+				OversizeHandling:       oversizeHandling(OversizeHandlingSkip),
+				OversizeThresholdBytes: pointer.Int32(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid max attempts",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				MaxAttempts:  pointer.Int32(5),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid max attempts",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				MaxAttempts:  pointer.Int32(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid circuit breaker",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				CircuitBreaker: &CircuitBreakerSpec{
+					FailureThreshold: 10,
+					PauseDuration:    metav1.Duration{Duration: time.Minute},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid circuit breaker failure threshold",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec: &eventingduck.DeliverySpec{},
+				CircuitBreaker: &CircuitBreakerSpec{
+					PauseDuration: metav1.Duration{Duration: time.Minute},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid circuit breaker pause duration",
+			ctx:  context.Background(),
+			given: &DeliverySpec{
+				DeliverySpec:   &eventingduck.DeliverySpec{},
+				CircuitBreaker: &CircuitBreakerSpec{FailureThreshold: 10},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.given.Validate(tt.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateFeatureFlagOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		wantErr   bool
+	}{
+		{
+			name:      "no overrides",
+			overrides: nil,
+			wantErr:   false,
+		},
+		{
+			name: "recognized overrides",
+			overrides: map[string]string{
+				"dispatcher.rate-limiter":             "enabled",
+				"dispatcher.ordered-executor-metrics": "Disabled",
+				"eventtype-auto-create":               "enabled",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "unknown flag",
+			overrides: map[string]string{"dispatcher.unknown": "enabled"},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid value",
+			overrides: map[string]string{"dispatcher.rate-limiter": "allowed"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateFeatureFlagOverrides(tt.overrides); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAuth_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		given   *Auth
+		wantErr bool
+	}{
+		{
+			name:    "no TLS settings",
+			ctx:     context.Background(),
+			given:   &Auth{},
+			wantErr: false,
+		},
+		{
+			name: "valid server name",
+			ctx:  context.Background(),
+			given: &Auth{
+				TLS: &TLSSpec{ServerName: pointer.String("kafka-0.example.com")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid server name",
+			ctx:  context.Background(),
+			given: &Auth{
+				TLS: &TLSSpec{ServerName: pointer.String("kafka:9092")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid TLS restriction",
+			ctx:  context.Background(),
+			given: &Auth{
+				TLS: &TLSSpec{
+					MinVersion:   pointer.String(TLSVersion12),
+					CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_AES_256_GCM_SHA384"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid min version",
+			ctx:  context.Background(),
+			given: &Auth{
+				TLS: &TLSSpec{MinVersion: pointer.String("TLSv1.0")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown cipher suite",
+			ctx:  context.Background(),
+			given: &Auth{
+				TLS: &TLSSpec{CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_UNKNOWN"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "insecure cipher suite",
+			ctx:  context.Background(),
+			given: &Auth{
+				TLS: &TLSSpec{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.given.Validate(tt.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateTopicRoutes(t *testing.T) {
+	subscriber := duckv1.Destination{
+		URI: &apis.URL{
+			Scheme: "http",
+			Host:   "127.0.0.1",
+		},
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		topics  []string
+		given   []TopicRoute
+		wantErr bool
+	}{
+		{
+			name:    "no routes",
+			ctx:     context.Background(),
+			topics:  []string{"t1"},
+			wantErr: false,
+		},
+		{
+			name:   "valid routes",
+			ctx:    context.Background(),
+			topics: []string{"t1", "t2", "t3"},
+			given: []TopicRoute{
+				{Topic: "t1", Subscriber: subscriber},
+				{Topic: "t2", Subscriber: subscriber},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "topic not in topics",
+			ctx:    context.Background(),
+			topics: []string{"t1"},
+			given: []TopicRoute{
+				{Topic: "t2", Subscriber: subscriber},
+			},
+			wantErr: true,
+		},
+		{
+			name:   "topic routed more than once",
+			ctx:    context.Background(),
+			topics: []string{"t1", "t2"},
+			given: []TopicRoute{
+				{Topic: "t1", Subscriber: subscriber},
+				{Topic: "t1", Subscriber: subscriber},
+			},
+			wantErr: true,
+		},
+		{
+			name:   "invalid subscriber",
+			ctx:    context.Background(),
+			topics: []string{"t1"},
+			given: []TopicRoute{
+				{Topic: "t1"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTopicRoutes(tt.ctx, tt.topics, tt.given); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRebalanceHooks_Validate(t *testing.T) {
+	hook := &duckv1.Destination{
+		URI: &apis.URL{
+			Scheme: "http",
+			Host:   "127.0.0.1",
+		},
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		given   *RebalanceHooks
+		wantErr bool
+	}{
+		{
+			name:    "unset",
+			ctx:     context.Background(),
+			wantErr: false,
+		},
+		{
+			name:    "valid hooks",
+			ctx:     context.Background(),
+			given:   &RebalanceHooks{OnRevoke: hook, OnAssign: hook},
+			wantErr: false,
+		},
+		{
+			name:    "valid on revoke only",
+			ctx:     context.Background(),
+			given:   &RebalanceHooks{OnRevoke: hook},
+			wantErr: false,
+		},
+		{
+			name:    "no hooks",
+			ctx:     context.Background(),
+			given:   &RebalanceHooks{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid hook",
+			ctx:     context.Background(),
+			given:   &RebalanceHooks{OnAssign: &duckv1.Destination{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.given.Validate(tt.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateRequiredExtensions(t *testing.T) {
+	policy := func(p MissingExtensionsPolicy) *MissingExtensionsPolicy { return &p }
+	tests := []struct {
+		name    string
+		names   []string
+		policy  *MissingExtensionsPolicy
+		wantErr bool
+	}{
+		{
+			name: "no requirements",
+		},
+		{
+			name:  "valid extensions",
+			names: []string{"tenant", "partitionkey"},
+		},
+		{
+			name:    "invalid extension",
+			names:   []string{"tenant", "Trace-ID"},
+			wantErr: true,
+		},
+		{
+			name:   "drop policy",
+			names:  []string{"tenant"},
+			policy: policy(MissingExtensionsDrop),
+		},
+		{
+			name:    "invalid policy",
+			names:   []string{"tenant"},
+			policy:  policy("retry"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateRequiredExtensions(tt.names, tt.policy); (err != nil) != tt.wantErr {
+				t.Errorf("want error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAudit_Validate(t *testing.T) {
+	level := func(l AuditLevel) *AuditLevel { return &l }
+	tests := []struct {
+		name    string
+		given   *Audit
+		wantErr bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:  "valid",
+			given: &Audit{Topic: "knative-audit.ns_1"},
+		},
+		{
+			name:  "valid failures level",
+			given: &Audit{Topic: "audit", Level: level(AuditLevelFailures)},
+		},
+		{
+			name:    "missing topic",
+			given:   &Audit{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid topic",
+			given:   &Audit{Topic: "audit/events"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid dot topic",
+			given:   &Audit{Topic: ".."},
+			wantErr: true,
+		},
+		{
+			name:    "too long topic",
+			given:   &Audit{Topic: strings.Repeat("a", 250)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid level",
+			given:   &Audit{Topic: "audit", Level: level("successes")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.given.Validate(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("want error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateDeliveryAttempts(t *testing.T) {
+	tests := []struct {
+		name      string
+		delivery  *DeliverySpec
+		overrides *duckv1.CloudEventOverrides
+		wantErr   bool
+	}{
+		{
+			name:    "no delivery",
+			wantErr: false,
+		},
+		{
+			name:     "attempts extension disabled",
+			delivery: &DeliverySpec{},
+			overrides: &duckv1.CloudEventOverrides{
+				Extensions: map[string]string{DeliveryAttemptsExtension: "1"},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "attempts extension enabled",
+			delivery: &DeliverySpec{EmitDeliveryAttempts: true},
+			overrides: &duckv1.CloudEventOverrides{
+				Extensions: map[string]string{"source": "s"},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "attempts extension overridden",
+			delivery: &DeliverySpec{EmitDeliveryAttempts: true},
+			overrides: &duckv1.CloudEventOverrides{
+				Extensions: map[string]string{DeliveryAttemptsExtension: "1"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateDeliveryAttempts(tt.delivery, tt.overrides); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateOrderingFallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		delivery *DeliverySpec
+		configs  ConsumerConfigs
+		wantErr  bool
+	}{
+		{
+			name:    "no fallback",
+			wantErr: false,
+		},
+		{
+			name:     "fallback with ordered delivery",
+			delivery: &DeliverySpec{Ordering: sources.Ordered},
+			configs:  ConsumerConfigs{OrderingFallback: pointer.String(OrderingFallbackError)},
+			wantErr:  false,
+		},
+		{
+			name:     "fallback with unordered delivery",
+			delivery: &DeliverySpec{Ordering: sources.Unordered},
+			configs:  ConsumerConfigs{OrderingFallback: pointer.String(OrderingFallbackUnordered)},
+			wantErr:  true,
+		},
+		{
+			name:    "fallback without delivery",
+			configs: ConsumerConfigs{OrderingFallback: pointer.String(OrderingFallbackError)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateOrderingFallback(tt.delivery, tt.configs); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateCommitInterval(t *testing.T) {
+	commitMode := func(m CommitMode) *CommitMode { return &m }
+	commitInterval := &metav1.Duration{Duration: time.Second}
+
+	tests := []struct {
+		name     string
+		delivery *DeliverySpec
+		configs  ConsumerConfigs
+		wantErr  bool
+	}{
+		{
+			name:    "no commit interval",
+			wantErr: false,
+		},
+		{
+			name:    "commit interval without delivery",
+			configs: ConsumerConfigs{CommitInterval: commitInterval},
+			wantErr: false,
+		},
+		{
+			name:     "commit interval with on-success commit mode",
+			delivery: &DeliverySpec{CommitMode: commitMode(CommitModeOnSuccess)},
+			configs:  ConsumerConfigs{CommitInterval: commitInterval},
+			wantErr:  false,
+		},
+		{
+			name:     "commit interval with on-receipt commit mode",
+			delivery: &DeliverySpec{CommitMode: commitMode(CommitModeOnReceipt)},
+			configs:  ConsumerConfigs{CommitInterval: commitInterval},
+			wantErr:  true,
+		},
+		{
+			name:     "on-receipt commit mode without commit interval",
+			delivery: &DeliverySpec{CommitMode: commitMode(CommitModeOnReceipt)},
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCommitInterval(tt.delivery, tt.configs); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateOrderingKeyHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		delivery *DeliverySpec
+		configs  ConsumerConfigs
+		wantErr  bool
+	}{
+		{
+			name:    "no header",
+			wantErr: false,
+		},
+		{
+			name:     "header with ordered delivery",
+			delivery: &DeliverySpec{Ordering: sources.Ordered},
+			configs:  ConsumerConfigs{OrderingKeyHeader: pointer.String("x-ordering-key")},
+			wantErr:  false,
+		},
+		{
+			name:     "header with unordered delivery",
+			delivery: &DeliverySpec{Ordering: sources.Unordered},
+			configs:  ConsumerConfigs{OrderingKeyHeader: pointer.String("x-ordering-key")},
+			wantErr:  true,
+		},
+		{
+			name:    "header without delivery",
+			configs: ConsumerConfigs{OrderingKeyHeader: pointer.String("x-ordering-key")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateOrderingKeyHeader(tt.delivery, tt.configs); (err != nil) != tt.wantErr {
+				t.Errorf("want err = %v, got err %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReplyStrategy_Validate(t *testing.T) {
+	policy := func(p MalformedReplyPolicy) *MalformedReplyPolicy { return &p }
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		given   *ReplyStrategy
+		wantErr bool
+	}{
+		{
+			name: "topic reply with on malformed",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply:  &TopicReply{Enabled: true},
+				OnMalformed: policy(MalformedReplyDeadLetter),
+			},
+			wantErr: false,
+		},
+		{
+			name: "URL reply with on malformed",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				URLReply:    &DestinationReply{Enabled: true},
+				OnMalformed: policy(MalformedReplyDrop),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid on malformed",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply:  &TopicReply{Enabled: true},
+				OnMalformed: policy("retry"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "topic reply with key attribute",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{Enabled: true, KeyAttribute: pointer.String("partitionkey")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "topic reply with invalid key attribute",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{Enabled: true, KeyAttribute: pointer.String("partition-key")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "on malformed without reply",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				NoReply:     &NoReply{Enabled: true},
+				OnMalformed: policy(MalformedReplyError),
+			},
+			wantErr: true,
+		},
+		{
+			name: "topic reply to another cluster",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{Enabled: true, BootstrapServers: pointer.String("SASL_SSL://local-1:9092, local-2:9092")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "topic reply to invalid bootstrap servers",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{Enabled: true, BootstrapServers: pointer.String("local-1:9092,local-2")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "topic reply auth without bootstrap servers",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{Enabled: true, NetSpec: &bindings.KafkaNetSpec{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "topic reply max producers",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{Enabled: true, MaxProducers: pointer.Int32(1)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "topic reply max producers below minimum",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{Enabled: true, MaxProducers: pointer.Int32(0)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "max producers without topic reply",
+			ctx:  context.Background(),
+			given: &ReplyStrategy{
+				TopicReply: &TopicReply{MaxProducers: pointer.Int32(2)},
+				NoReply:    &NoReply{Enabled: true},
+			},
 			wantErr: true,
 		},
 	}
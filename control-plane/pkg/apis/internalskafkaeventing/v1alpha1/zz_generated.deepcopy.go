@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	v1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/bindings/v1"
 	eventingv1alpha1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/eventing/v1alpha1"
@@ -32,6 +33,27 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Audit) DeepCopyInto(out *Audit) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(AuditLevel)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Audit.
+func (in *Audit) DeepCopy() *Audit {
+	if in == nil {
+		return nil
+	}
+	out := new(Audit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Auth) DeepCopyInto(out *Auth) {
 	*out = *in
@@ -50,6 +72,11 @@ func (in *Auth) DeepCopyInto(out *Auth) {
 		*out = new(SecretSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -89,6 +116,23 @@ func (in ByReadinessAndCreationTime) DeepCopy() ByReadinessAndCreationTime {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakerSpec) DeepCopyInto(out *CircuitBreakerSpec) {
+	*out = *in
+	out.PauseDuration = in.PauseDuration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerSpec.
+func (in *CircuitBreakerSpec) DeepCopy() *CircuitBreakerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Consumer) DeepCopyInto(out *Consumer) {
 	*out = *in
@@ -132,6 +176,166 @@ func (in *ConsumerConfigs) DeepCopyInto(out *ConsumerConfigs) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.StripHeaders != nil {
+		in, out := &in.StripHeaders, &out.StripHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReconnectBackoff != nil {
+		in, out := &in.ReconnectBackoff, &out.ReconnectBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ReconnectBackoffMax != nil {
+		in, out := &in.ReconnectBackoffMax, &out.ReconnectBackoffMax
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CommitInterval != nil {
+		in, out := &in.CommitInterval, &out.CommitInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FetchMaxWait != nil {
+		in, out := &in.FetchMaxWait, &out.FetchMaxWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FetchMaxBytes != nil {
+		in, out := &in.FetchMaxBytes, &out.FetchMaxBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReceiveBufferBytes != nil {
+		in, out := &in.ReceiveBufferBytes, &out.ReceiveBufferBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContentMode != nil {
+		in, out := &in.ContentMode, &out.ContentMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionAssignor != nil {
+		in, out := &in.PartitionAssignor, &out.PartitionAssignor
+		*out = new(string)
+		**out = **in
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LagBasedScaleTarget != nil {
+		in, out := &in.LagBasedScaleTarget, &out.LagBasedScaleTarget
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SubscriberMaxConns != nil {
+		in, out := &in.SubscriberMaxConns, &out.SubscriberMaxConns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SubscriberMaxIdleConns != nil {
+		in, out := &in.SubscriberMaxIdleConns, &out.SubscriberMaxIdleConns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OnTopicDeleted != nil {
+		in, out := &in.OnTopicDeleted, &out.OnTopicDeleted
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricsSampleRate != nil {
+		in, out := &in.MetricsSampleRate, &out.MetricsSampleRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OrderingFallback != nil {
+		in, out := &in.OrderingFallback, &out.OrderingFallback
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrderingKeyHeader != nil {
+		in, out := &in.OrderingKeyHeader, &out.OrderingKeyHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.OnTombstone != nil {
+		in, out := &in.OnTombstone, &out.OnTombstone
+		*out = new(string)
+		**out = **in
+	}
+	if in.RebalanceTimeout != nil {
+		in, out := &in.RebalanceTimeout, &out.RebalanceTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TimeSource != nil {
+		in, out := &in.TimeSource, &out.TimeSource
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeSourceHeader != nil {
+		in, out := &in.TimeSourceHeader, &out.TimeSourceHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.FlowControlWebhook != nil {
+		in, out := &in.FlowControlWebhook, &out.FlowControlWebhook
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalGroupID != nil {
+		in, out := &in.AdditionalGroupID, &out.AdditionalGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HonorRetryAfter != nil {
+		in, out := &in.HonorRetryAfter, &out.HonorRetryAfter
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ShutdownGrace != nil {
+		in, out := &in.ShutdownGrace, &out.ShutdownGrace
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DeliveryProxyURL != nil {
+		in, out := &in.DeliveryProxyURL, &out.DeliveryProxyURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.NullKeyPolicy != nil {
+		in, out := &in.NullKeyPolicy, &out.NullKeyPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IdGenerationStrategy != nil {
+		in, out := &in.IdGenerationStrategy, &out.IdGenerationStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxConsecutiveDeserFailures != nil {
+		in, out := &in.MaxConsecutiveDeserFailures, &out.MaxConsecutiveDeserFailures
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OnAuthorizationFailure != nil {
+		in, out := &in.OnAuthorizationFailure, &out.OnAuthorizationFailure
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -347,6 +551,11 @@ func (in *ConsumerSpec) DeepCopyInto(out *ConsumerSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Subscriber.DeepCopyInto(&out.Subscriber)
+	if in.SubscriberClientCertSecretRef != nil {
+		in, out := &in.SubscriberClientCertSecretRef, &out.SubscriberClientCertSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 	if in.CloudEventOverrides != nil {
 		in, out := &in.CloudEventOverrides, &out.CloudEventOverrides
 		*out = new(duckv1.CloudEventOverrides)
@@ -367,6 +576,70 @@ func (in *ConsumerSpec) DeepCopyInto(out *ConsumerSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FeatureFlagOverrides != nil {
+		in, out := &in.FeatureFlagOverrides, &out.FeatureFlagOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TopicPartitions != nil {
+		in, out := &in.TopicPartitions, &out.TopicPartitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TopicRoutes != nil {
+		in, out := &in.TopicRoutes, &out.TopicRoutes
+		*out = make([]TopicRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RebalanceHooks != nil {
+		in, out := &in.RebalanceHooks, &out.RebalanceHooks
+		*out = new(RebalanceHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpectedThroughput != nil {
+		in, out := &in.ExpectedThroughput, &out.ExpectedThroughput
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FinalizeDrainTimeout != nil {
+		in, out := &in.FinalizeDrainTimeout, &out.FinalizeDrainTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ShadowSubscriber != nil {
+		in, out := &in.ShadowSubscriber, &out.ShadowSubscriber
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShadowSamplePercent != nil {
+		in, out := &in.ShadowSamplePercent, &out.ShadowSamplePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FetchConcurrency != nil {
+		in, out := &in.FetchConcurrency, &out.FetchConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequiredExtensions != nil {
+		in, out := &in.RequiredExtensions, &out.RequiredExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OnMissingExtensions != nil {
+		in, out := &in.OnMissingExtensions, &out.OnMissingExtensions
+		*out = new(MissingExtensionsPolicy)
+		**out = **in
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(Audit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -399,7 +672,36 @@ func (in *ConsumerStatus) DeepCopyInto(out *ConsumerStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FlowControlWebhookURI != nil {
+		in, out := &in.FlowControlWebhookURI, &out.FlowControlWebhookURI
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
 	in.DeliveryStatus.DeepCopyInto(&out.DeliveryStatus)
+	if in.PartitionExpansions != nil {
+		in, out := &in.PartitionExpansions, &out.PartitionExpansions
+		*out = make([]PartitionExpansion, len(*in))
+		copy(*out, *in)
+	}
+	if in.VReplicas != nil {
+		in, out := &in.VReplicas, &out.VReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExpectedThroughput != nil {
+		in, out := &in.ExpectedThroughput, &out.ExpectedThroughput
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BoundAt != nil {
+		in, out := &in.BoundAt, &out.BoundAt
+		*out = (*in).DeepCopy()
+	}
+	if in.EffectiveConcurrency != nil {
+		in, out := &in.EffectiveConcurrency, &out.EffectiveConcurrency
+		*out = new(EffectiveConcurrency)
+		**out = **in
+	}
 	return
 }
 
@@ -431,6 +733,22 @@ func (in *ConsumerTemplateSpec) DeepCopy() *ConsumerTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeliverySigning) DeepCopyInto(out *DeliverySigning) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliverySigning.
+func (in *DeliverySigning) DeepCopy() *DeliverySigning {
+	if in == nil {
+		return nil
+	}
+	out := new(DeliverySigning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeliverySpec) DeepCopyInto(out *DeliverySpec) {
 	*out = *in
@@ -439,6 +757,86 @@ func (in *DeliverySpec) DeepCopyInto(out *DeliverySpec) {
 		*out = new(apisduckv1.DeliverySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryDeadline != nil {
+		in, out := &in.RetryDeadline, &out.RetryDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRetryDuration != nil {
+		in, out := &in.MaxRetryDuration, &out.MaxRetryDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UserAgent != nil {
+		in, out := &in.UserAgent, &out.UserAgent
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeadLetterRequired != nil {
+		in, out := &in.DeadLetterRequired, &out.DeadLetterRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeadLetterReasonExtension != nil {
+		in, out := &in.DeadLetterReasonExtension, &out.DeadLetterReasonExtension
+		*out = new(string)
+		**out = **in
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreakerSpec)
+		**out = **in
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PayloadFormat != nil {
+		in, out := &in.PayloadFormat, &out.PayloadFormat
+		*out = new(PayloadFormat)
+		**out = **in
+	}
+	if in.DeadLetterContentMode != nil {
+		in, out := &in.DeadLetterContentMode, &out.DeadLetterContentMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeadLetterCACerts != nil {
+		in, out := &in.DeadLetterCACerts, &out.DeadLetterCACerts
+		*out = new(string)
+		**out = **in
+	}
+	if in.DedupWindow != nil {
+		in, out := &in.DedupWindow, &out.DedupWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StallWindow != nil {
+		in, out := &in.StallWindow, &out.StallWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OversizeHandling != nil {
+		in, out := &in.OversizeHandling, &out.OversizeHandling
+		*out = new(OversizeHandling)
+		**out = **in
+	}
+	if in.OversizeThresholdBytes != nil {
+		in, out := &in.OversizeThresholdBytes, &out.OversizeThresholdBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CommitMode != nil {
+		in, out := &in.CommitMode, &out.CommitMode
+		*out = new(CommitMode)
+		**out = **in
+	}
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(DeliverySigning)
+		**out = **in
+	}
 	return
 }
 
@@ -469,6 +867,22 @@ func (in *DestinationReply) DeepCopy() *DestinationReply {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveConcurrency) DeepCopyInto(out *EffectiveConcurrency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveConcurrency.
+func (in *EffectiveConcurrency) DeepCopy() *EffectiveConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filters) DeepCopyInto(out *Filters) {
 	*out = *in
@@ -513,6 +927,22 @@ func (in *NoReply) DeepCopy() *NoReply {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartitionExpansion) DeepCopyInto(out *PartitionExpansion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartitionExpansion.
+func (in *PartitionExpansion) DeepCopy() *PartitionExpansion {
+	if in == nil {
+		return nil
+	}
+	out := new(PartitionExpansion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodBind) DeepCopyInto(out *PodBind) {
 	*out = *in
@@ -529,13 +959,39 @@ func (in *PodBind) DeepCopy() *PodBind {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalanceHooks) DeepCopyInto(out *RebalanceHooks) {
+	*out = *in
+	if in.OnRevoke != nil {
+		in, out := &in.OnRevoke, &out.OnRevoke
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnAssign != nil {
+		in, out := &in.OnAssign, &out.OnAssign
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalanceHooks.
+func (in *RebalanceHooks) DeepCopy() *RebalanceHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalanceHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplyStrategy) DeepCopyInto(out *ReplyStrategy) {
 	*out = *in
 	if in.TopicReply != nil {
 		in, out := &in.TopicReply, &out.TopicReply
 		*out = new(TopicReply)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.URLReply != nil {
 		in, out := &in.URLReply, &out.URLReply
@@ -547,6 +1003,11 @@ func (in *ReplyStrategy) DeepCopyInto(out *ReplyStrategy) {
 		*out = new(NoReply)
 		**out = **in
 	}
+	if in.OnMalformed != nil {
+		in, out := &in.OnMalformed, &out.OnMalformed
+		*out = new(MalformedReplyPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -597,9 +1058,60 @@ func (in *SecretSpec) DeepCopy() *SecretSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
+	*out = *in
+	if in.ServerName != nil {
+		in, out := &in.ServerName, &out.ServerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinVersion != nil {
+		in, out := &in.MinVersion, &out.MinVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSpec.
+func (in *TLSSpec) DeepCopy() *TLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopicReply) DeepCopyInto(out *TopicReply) {
 	*out = *in
+	if in.KeyAttribute != nil {
+		in, out := &in.KeyAttribute, &out.KeyAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetSpec != nil {
+		in, out := &in.NetSpec, &out.NetSpec
+		*out = new(v1.KafkaNetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxProducers != nil {
+		in, out := &in.MaxProducers, &out.MaxProducers
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -612,3 +1124,20 @@ func (in *TopicReply) DeepCopy() *TopicReply {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicRoute) DeepCopyInto(out *TopicRoute) {
+	*out = *in
+	in.Subscriber.DeepCopyInto(&out.Subscriber)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicRoute.
+func (in *TopicRoute) DeepCopy() *TopicRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicRoute)
+	in.DeepCopyInto(out)
+	return out
+}
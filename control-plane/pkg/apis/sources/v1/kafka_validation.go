@@ -18,8 +18,11 @@ package v1
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmp"
 )
 
@@ -38,6 +41,7 @@ func (kss *KafkaSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 
 	// Validate source spec
 	errs = errs.Also(kss.SourceSpec.Validate(ctx))
+	errs = errs.Also(ValidateCloudEventOverrides(kss.CloudEventOverrides).ViaField("ceOverrides"))
 
 	// Check for mandatory fields
 	if len(kss.Topics) <= 0 {
@@ -62,6 +66,34 @@ func (kss *KafkaSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 	return errs
 }
 
+// ValidateCloudEventOverrides rejects the extensions whose names don't conform to the CloudEvents
+// attribute naming rules, since they're set verbatim on the events by the dispatcher.
+func ValidateCloudEventOverrides(overrides *duckv1.CloudEventOverrides) *apis.FieldError {
+	if overrides == nil {
+		return nil
+	}
+	var err *apis.FieldError
+	for _, key := range slices.Sorted(maps.Keys(overrides.Extensions)) {
+		if !IsValidExtensionName(key) {
+			err = err.Also(apis.ErrInvalidKeyName(key, "extensions", "expected a CloudEvent attribute name made of lowercase letters and digits"))
+		}
+	}
+	return err
+}
+
+// IsValidExtensionName reports whether name is a valid CloudEvent attribute name.
+func IsValidExtensionName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
 func (ks *KafkaSource) CheckImmutableFields(ctx context.Context, original *KafkaSource) *apis.FieldError {
 	if original == nil {
 		return nil
@@ -18,6 +18,8 @@ package v1
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -125,6 +127,27 @@ func TestKafka_Validate(t *testing.T) {
 			ctx:  context.Background(),
 			want: apis.ErrInvalidValue(badInitialOffset, "spec.initialOffset"),
 		},
+		{
+			name: "uppercase ce overrides extension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					ConsumerGroup: "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+						CloudEventOverrides: &duckv1.CloudEventOverrides{
+							Extensions: map[string]string{"Team": "a"},
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+			want: apis.ErrInvalidKeyName("Team", "spec.ceOverrides.extensions",
+				"expected a CloudEvent attribute name made of lowercase letters and digits"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -164,3 +187,47 @@ func NewSourceSinkReference() duckv1.Destination {
 		},
 	}
 }
+
+func TestValidateCloudEventOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides *duckv1.CloudEventOverrides
+		wantKeys  []string
+	}{
+		{
+			name: "no overrides",
+		},
+		{
+			name: "valid extensions",
+			overrides: &duckv1.CloudEventOverrides{
+				Extensions: map[string]string{"source1": "s", "team": "a"},
+			},
+		},
+		{
+			name: "invalid extensions",
+			overrides: &duckv1.CloudEventOverrides{
+				Extensions: map[string]string{"Team": "a", "my-ext": "b", "valid": "c"},
+			},
+			wantKeys: []string{"Team", "my-ext"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCloudEventOverrides(tt.overrides).ViaField("ceOverrides")
+			if len(tt.wantKeys) == 0 {
+				if err != nil {
+					t.Errorf("want no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("want error on %v, got nil", tt.wantKeys)
+			}
+			for _, key := range tt.wantKeys {
+				if !strings.Contains(err.Error(), strconv.Quote(key)+": ceOverrides.extensions") {
+					t.Errorf("want error naming key %s, got %v", key, err)
+				}
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmp"
+
+	v1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1"
 )
 
 // Validate ensures KafkaSource is properly configured.
@@ -38,6 +40,7 @@ func (kss *KafkaSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 
 	// Validate source spec
 	errs = errs.Also(kss.SourceSpec.Validate(ctx))
+	errs = errs.Also(v1.ValidateCloudEventOverrides(kss.CloudEventOverrides).ViaField("ceOverrides"))
 
 	// Check for mandatory fields
 	if len(kss.Topics) <= 0 {
@@ -124,6 +124,27 @@ func TestKafka_Validate(t *testing.T) {
 			ctx:  context.Background(),
 			want: apis.ErrInvalidValue(badInitialOffset, "spec.initialOffset"),
 		},
+		{
+			name: "uppercase ce overrides extension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					ConsumerGroup: "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+						CloudEventOverrides: &duckv1.CloudEventOverrides{
+							Extensions: map[string]string{"Team": "a"},
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+			want: apis.ErrInvalidKeyName("Team", "spec.ceOverrides.extensions",
+				"expected a CloudEvent attribute name made of lowercase letters and digits"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
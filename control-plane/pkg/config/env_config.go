@@ -45,6 +45,10 @@ type Env struct {
 	SystemNamespace         string `required:"true" split_words:"true"`
 	ContractConfigMapFormat string `required:"true" split_words:"true"`
 	DefaultBackoffDelayMs   uint64 `required:"false" split_words:"true"`
+
+	// ContractBackupDirectory is the directory where a copy of the contracts is stored, for example a volume
+	// backed by an object store bucket. The contracts aren't backed up when empty.
+	ContractBackupDirectory string `required:"false" split_words:"true"`
 }
 
 // ValidationOption represents a function to validate the Env configurations.
@@ -70,6 +70,63 @@ func (BackoffPolicy) EnumDescriptor() ([]byte, []int) {
 	return file_contract_proto_rawDescGZIP(), []int{0}
 }
 
+// Handling of the events exceeding the size threshold.
+type OversizeHandling int32
+
+const (
+	// The events are delivered, and retried on failure, like any other event.
+	OversizeHandling_OVERSIZE_NONE OversizeHandling = 0
+	// The events are sent to the dead letter sink, if any.
+	OversizeHandling_OVERSIZE_DEAD_LETTER OversizeHandling = 1
+	// The events are dropped and their offsets committed.
+	OversizeHandling_OVERSIZE_SKIP OversizeHandling = 2
+	// The payload of the events is truncated to the size threshold.
+	OversizeHandling_OVERSIZE_TRUNCATE OversizeHandling = 3
+)
+
+// Enum value maps for OversizeHandling.
+var (
+	OversizeHandling_name = map[int32]string{
+		0: "OVERSIZE_NONE",
+		1: "OVERSIZE_DEAD_LETTER",
+		2: "OVERSIZE_SKIP",
+		3: "OVERSIZE_TRUNCATE",
+	}
+	OversizeHandling_value = map[string]int32{
+		"OVERSIZE_NONE":        0,
+		"OVERSIZE_DEAD_LETTER": 1,
+		"OVERSIZE_SKIP":        2,
+		"OVERSIZE_TRUNCATE":    3,
+	}
+)
+
+func (x OversizeHandling) Enum() *OversizeHandling {
+	p := new(OversizeHandling)
+	*p = x
+	return p
+}
+
+func (x OversizeHandling) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OversizeHandling) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[1].Descriptor()
+}
+
+func (OversizeHandling) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[1]
+}
+
+func (x OversizeHandling) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OversizeHandling.Descriptor instead.
+func (OversizeHandling) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{1}
+}
+
 // Check dev.knative.eventing.kafka.broker.dispatcher.consumer.DeliveryOrder for more details
 type DeliveryOrder int32
 
@@ -101,11 +158,11 @@ func (x DeliveryOrder) String() string {
 }
 
 func (DeliveryOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[1].Descriptor()
+	return file_contract_proto_enumTypes[2].Descriptor()
 }
 
 func (DeliveryOrder) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[1]
+	return &file_contract_proto_enumTypes[2]
 }
 
 func (x DeliveryOrder) Number() protoreflect.EnumNumber {
@@ -114,7 +171,7 @@ func (x DeliveryOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DeliveryOrder.Descriptor instead.
 func (DeliveryOrder) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{1}
+	return file_contract_proto_rawDescGZIP(), []int{2}
 }
 
 type KeyType int32
@@ -153,11 +210,11 @@ func (x KeyType) String() string {
 }
 
 func (KeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[2].Descriptor()
+	return file_contract_proto_enumTypes[3].Descriptor()
 }
 
 func (KeyType) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[2]
+	return &file_contract_proto_enumTypes[3]
 }
 
 func (x KeyType) Number() protoreflect.EnumNumber {
@@ -166,289 +223,950 @@ func (x KeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyType.Descriptor instead.
 func (KeyType) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{2}
+	return file_contract_proto_rawDescGZIP(), []int{3}
 }
 
-// CloudEvent content mode
-type ContentMode int32
+type MalformedReply int32
 
 const (
-	ContentMode_BINARY     ContentMode = 0
-	ContentMode_STRUCTURED ContentMode = 1
+	// The malformed reply is considered a delivery failure.
+	MalformedReply_ERROR MalformedReply = 0
+	// The malformed reply is discarded and the event is considered delivered.
+	MalformedReply_DROP MalformedReply = 1
+	// The event is sent to the dead letter sink.
+	MalformedReply_DEAD_LETTER MalformedReply = 2
 )
 
-// Enum value maps for ContentMode.
+// Enum value maps for MalformedReply.
 var (
-	ContentMode_name = map[int32]string{
-		0: "BINARY",
-		1: "STRUCTURED",
+	MalformedReply_name = map[int32]string{
+		0: "ERROR",
+		1: "DROP",
+		2: "DEAD_LETTER",
 	}
-	ContentMode_value = map[string]int32{
-		"BINARY":     0,
-		"STRUCTURED": 1,
+	MalformedReply_value = map[string]int32{
+		"ERROR":       0,
+		"DROP":        1,
+		"DEAD_LETTER": 2,
 	}
 )
 
-func (x ContentMode) Enum() *ContentMode {
-	p := new(ContentMode)
+func (x MalformedReply) Enum() *MalformedReply {
+	p := new(MalformedReply)
 	*p = x
 	return p
 }
 
-func (x ContentMode) String() string {
+func (x MalformedReply) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ContentMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[3].Descriptor()
+func (MalformedReply) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[4].Descriptor()
 }
 
-func (ContentMode) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[3]
+func (MalformedReply) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[4]
 }
 
-func (x ContentMode) Number() protoreflect.EnumNumber {
+func (x MalformedReply) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ContentMode.Descriptor instead.
-func (ContentMode) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MalformedReply.Descriptor instead.
+func (MalformedReply) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{4}
 }
 
-type SecretField int32
+// Partition assignment strategy of the classic consumer group protocol.
+type PartitionAssignor int32
 
 const (
-	SecretField_SASL_MECHANISM SecretField = 0
-	SecretField_CA_CRT         SecretField = 1
-	SecretField_USER_CRT       SecretField = 2
-	SecretField_USER_KEY       SecretField = 3
-	SecretField_USER           SecretField = 4
-	SecretField_PASSWORD       SecretField = 5
+	PartitionAssignor_COOPERATIVE_STICKY PartitionAssignor = 0
+	PartitionAssignor_RANGE              PartitionAssignor = 1
+	PartitionAssignor_ROUND_ROBIN        PartitionAssignor = 2
+	PartitionAssignor_STICKY             PartitionAssignor = 3
 )
 
-// Enum value maps for SecretField.
+// Enum value maps for PartitionAssignor.
 var (
-	SecretField_name = map[int32]string{
-		0: "SASL_MECHANISM",
-		1: "CA_CRT",
-		2: "USER_CRT",
-		3: "USER_KEY",
-		4: "USER",
-		5: "PASSWORD",
-	}
-	SecretField_value = map[string]int32{
-		"SASL_MECHANISM": 0,
-		"CA_CRT":         1,
-		"USER_CRT":       2,
-		"USER_KEY":       3,
-		"USER":           4,
-		"PASSWORD":       5,
+	PartitionAssignor_name = map[int32]string{
+		0: "COOPERATIVE_STICKY",
+		1: "RANGE",
+		2: "ROUND_ROBIN",
+		3: "STICKY",
+	}
+	PartitionAssignor_value = map[string]int32{
+		"COOPERATIVE_STICKY": 0,
+		"RANGE":              1,
+		"ROUND_ROBIN":        2,
+		"STICKY":             3,
 	}
 )
 
-func (x SecretField) Enum() *SecretField {
-	p := new(SecretField)
+func (x PartitionAssignor) Enum() *PartitionAssignor {
+	p := new(PartitionAssignor)
 	*p = x
 	return p
 }
 
-func (x SecretField) String() string {
+func (x PartitionAssignor) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (SecretField) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[4].Descriptor()
+func (PartitionAssignor) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[5].Descriptor()
 }
 
-func (SecretField) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[4]
+func (PartitionAssignor) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[5]
 }
 
-func (x SecretField) Number() protoreflect.EnumNumber {
+func (x PartitionAssignor) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use SecretField.Descriptor instead.
-func (SecretField) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PartitionAssignor.Descriptor instead.
+func (PartitionAssignor) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{5}
 }
 
-type Protocol int32
+// Format of the payload sent to the destination.
+type PayloadFormat int32
 
 const (
-	Protocol_PLAINTEXT      Protocol = 0
-	Protocol_SASL_PLAINTEXT Protocol = 1
-	Protocol_SSL            Protocol = 2
-	Protocol_SASL_SSL       Protocol = 3
+	// The CloudEvent is sent.
+	PayloadFormat_CLOUDEVENT PayloadFormat = 0
+	// The Kafka record value is sent as the request body and each record header is sent
+	// as a request header with the same name and value, the record key isn't sent.
+	PayloadFormat_RAW PayloadFormat = 1
 )
 
-// Enum value maps for Protocol.
+// Enum value maps for PayloadFormat.
 var (
-	Protocol_name = map[int32]string{
-		0: "PLAINTEXT",
-		1: "SASL_PLAINTEXT",
-		2: "SSL",
-		3: "SASL_SSL",
+	PayloadFormat_name = map[int32]string{
+		0: "CLOUDEVENT",
+		1: "RAW",
 	}
-	Protocol_value = map[string]int32{
-		"PLAINTEXT":      0,
-		"SASL_PLAINTEXT": 1,
-		"SSL":            2,
-		"SASL_SSL":       3,
+	PayloadFormat_value = map[string]int32{
+		"CLOUDEVENT": 0,
+		"RAW":        1,
 	}
 )
 
-func (x Protocol) Enum() *Protocol {
-	p := new(Protocol)
+func (x PayloadFormat) Enum() *PayloadFormat {
+	p := new(PayloadFormat)
 	*p = x
 	return p
 }
 
-func (x Protocol) String() string {
+func (x PayloadFormat) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Protocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[5].Descriptor()
+func (PayloadFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[6].Descriptor()
 }
 
-func (Protocol) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[5]
+func (PayloadFormat) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[6]
 }
 
-func (x Protocol) Number() protoreflect.EnumNumber {
+func (x PayloadFormat) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Protocol.Descriptor instead.
-func (Protocol) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PayloadFormat.Descriptor instead.
+func (PayloadFormat) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{6}
 }
 
-// We don't use the google.protobuf.Empty type because
-// configuring the include directory is a mess for the contributors and for the build scripts.
-// Hence, more than dealing with contributors that can't get their dev environment
-// working with the project, we prefer to have this additional single line of code.
-// Protobuf include nightmare? No thanks!
-type Empty struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+// When the offset of an event is committed.
+type CommitMode int32
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+const (
+	// The offset is committed once the event is delivered, or sent to the dead letter sink (at-least-once).
+	CommitMode_COMMIT_ON_SUCCESS CommitMode = 0
+	// The offset is committed as soon as the event is read (at-most-once).
+	CommitMode_COMMIT_ON_RECEIPT CommitMode = 1
+)
+
+// Enum value maps for CommitMode.
+var (
+	CommitMode_name = map[int32]string{
+		0: "COMMIT_ON_SUCCESS",
+		1: "COMMIT_ON_RECEIPT",
 	}
-}
+	CommitMode_value = map[string]int32{
+		"COMMIT_ON_SUCCESS": 0,
+		"COMMIT_ON_RECEIPT": 1,
+	}
+)
 
-func (x *Empty) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CommitMode) Enum() *CommitMode {
+	p := new(CommitMode)
+	*p = x
+	return p
 }
 
-func (*Empty) ProtoMessage() {}
+func (x CommitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CommitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[7].Descriptor()
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{0}
+func (CommitMode) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[7]
 }
 
-type Exact struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x CommitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+// Deprecated: Use CommitMode.Descriptor instead.
+func (CommitMode) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Exact) Reset() {
-	*x = Exact{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Handling of the records without a key.
+type NullKeyPolicy int32
+
+const (
+	// The records are dispatched like any other record.
+	NullKeyPolicy_NULL_KEY_ROUND_ROBIN NullKeyPolicy = 0
+	// The records are rejected and sent to the dead letter sink, if any.
+	NullKeyPolicy_NULL_KEY_REJECT NullKeyPolicy = 1
+	// The records are dropped and their offsets committed.
+	NullKeyPolicy_NULL_KEY_DROP NullKeyPolicy = 2
+)
+
+// Enum value maps for NullKeyPolicy.
+var (
+	NullKeyPolicy_name = map[int32]string{
+		0: "NULL_KEY_ROUND_ROBIN",
+		1: "NULL_KEY_REJECT",
+		2: "NULL_KEY_DROP",
+	}
+	NullKeyPolicy_value = map[string]int32{
+		"NULL_KEY_ROUND_ROBIN": 0,
+		"NULL_KEY_REJECT":      1,
+		"NULL_KEY_DROP":        2,
 	}
+)
+
+func (x NullKeyPolicy) Enum() *NullKeyPolicy {
+	p := new(NullKeyPolicy)
+	*p = x
+	return p
 }
 
-func (x *Exact) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x NullKeyPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Exact) ProtoMessage() {}
+func (NullKeyPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[8].Descriptor()
+}
 
-func (x *Exact) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (NullKeyPolicy) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[8]
 }
 
-// Deprecated: Use Exact.ProtoReflect.Descriptor instead.
-func (*Exact) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{1}
+func (x NullKeyPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Exact) GetAttributes() map[string]string {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
+// Deprecated: Use NullKeyPolicy.Descriptor instead.
+func (NullKeyPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{8}
 }
 
-type Prefix struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Delivery of the events that can't be delivered in order.
+type OrderingFallback int32
 
-	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
+const (
+	// The events are delivered without ordering guarantees.
+	OrderingFallback_ORDERING_FALLBACK_UNORDERED OrderingFallback = 0
+	// The events are handled as delivery failures.
+	OrderingFallback_ORDERING_FALLBACK_ERROR OrderingFallback = 1
+)
 
-func (x *Prefix) Reset() {
-	*x = Prefix{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for OrderingFallback.
+var (
+	OrderingFallback_name = map[int32]string{
+		0: "ORDERING_FALLBACK_UNORDERED",
+		1: "ORDERING_FALLBACK_ERROR",
 	}
+	OrderingFallback_value = map[string]int32{
+		"ORDERING_FALLBACK_UNORDERED": 0,
+		"ORDERING_FALLBACK_ERROR":     1,
+	}
+)
+
+func (x OrderingFallback) Enum() *OrderingFallback {
+	p := new(OrderingFallback)
+	*p = x
+	return p
 }
 
-func (x *Prefix) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x OrderingFallback) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Prefix) ProtoMessage() {}
+func (OrderingFallback) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[9].Descriptor()
+}
 
-func (x *Prefix) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (OrderingFallback) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[9]
+}
+
+func (x OrderingFallback) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderingFallback.Descriptor instead.
+func (OrderingFallback) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{9}
+}
+
+// Handling of the tombstones, the records without a value.
+type TombstonePolicy int32
+
+const (
+	// The tombstones are delivered as events without data.
+	TombstonePolicy_TOMBSTONE_DELIVER TombstonePolicy = 0
+	// The tombstones are committed without being delivered.
+	TombstonePolicy_TOMBSTONE_SKIP TombstonePolicy = 1
+)
+
+// Enum value maps for TombstonePolicy.
+var (
+	TombstonePolicy_name = map[int32]string{
+		0: "TOMBSTONE_DELIVER",
+		1: "TOMBSTONE_SKIP",
+	}
+	TombstonePolicy_value = map[string]int32{
+		"TOMBSTONE_DELIVER": 0,
+		"TOMBSTONE_SKIP":    1,
+	}
+)
+
+func (x TombstonePolicy) Enum() *TombstonePolicy {
+	p := new(TombstonePolicy)
+	*p = x
+	return p
+}
+
+func (x TombstonePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TombstonePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[10].Descriptor()
+}
+
+func (TombstonePolicy) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[10]
+}
+
+func (x TombstonePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TombstonePolicy.Descriptor instead.
+func (TombstonePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{10}
+}
+
+// Reaction of the dispatcher to the deletion of a topic.
+type TopicDeletedPolicy int32
+
+const (
+	// The dispatcher waits for the topic to be created again.
+	TopicDeletedPolicy_TOPIC_DELETED_WAIT TopicDeletedPolicy = 0
+	// The dispatcher reports an error until the topic is created again.
+	TopicDeletedPolicy_TOPIC_DELETED_ERROR TopicDeletedPolicy = 1
+	// The dispatcher waits for the control plane to create the topic again.
+	TopicDeletedPolicy_TOPIC_DELETED_RECREATE TopicDeletedPolicy = 2
+)
+
+// Enum value maps for TopicDeletedPolicy.
+var (
+	TopicDeletedPolicy_name = map[int32]string{
+		0: "TOPIC_DELETED_WAIT",
+		1: "TOPIC_DELETED_ERROR",
+		2: "TOPIC_DELETED_RECREATE",
+	}
+	TopicDeletedPolicy_value = map[string]int32{
+		"TOPIC_DELETED_WAIT":     0,
+		"TOPIC_DELETED_ERROR":    1,
+		"TOPIC_DELETED_RECREATE": 2,
+	}
+)
+
+func (x TopicDeletedPolicy) Enum() *TopicDeletedPolicy {
+	p := new(TopicDeletedPolicy)
+	*p = x
+	return p
+}
+
+func (x TopicDeletedPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopicDeletedPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[11].Descriptor()
+}
+
+func (TopicDeletedPolicy) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[11]
+}
+
+func (x TopicDeletedPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicDeletedPolicy.Descriptor instead.
+func (TopicDeletedPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{11}
+}
+
+// Reaction of the dispatcher to the consumer group authorization errors, raised when the
+// principal loses access to the consumer group while consuming.
+type AuthorizationFailurePolicy int32
+
+const (
+	// The dispatcher retries with backoff until the access is granted again.
+	AuthorizationFailurePolicy_AUTHORIZATION_FAILURE_RETRY_WITH_BACKOFF AuthorizationFailurePolicy = 0
+	// The dispatcher pauses the consumption until the egress is updated.
+	AuthorizationFailurePolicy_AUTHORIZATION_FAILURE_PAUSE AuthorizationFailurePolicy = 1
+	// The dispatcher stops the egress and reports the failure.
+	AuthorizationFailurePolicy_AUTHORIZATION_FAILURE_FAIL_FAST AuthorizationFailurePolicy = 2
+)
+
+// Enum value maps for AuthorizationFailurePolicy.
+var (
+	AuthorizationFailurePolicy_name = map[int32]string{
+		0: "AUTHORIZATION_FAILURE_RETRY_WITH_BACKOFF",
+		1: "AUTHORIZATION_FAILURE_PAUSE",
+		2: "AUTHORIZATION_FAILURE_FAIL_FAST",
+	}
+	AuthorizationFailurePolicy_value = map[string]int32{
+		"AUTHORIZATION_FAILURE_RETRY_WITH_BACKOFF": 0,
+		"AUTHORIZATION_FAILURE_PAUSE":              1,
+		"AUTHORIZATION_FAILURE_FAIL_FAST":          2,
+	}
+)
+
+func (x AuthorizationFailurePolicy) Enum() *AuthorizationFailurePolicy {
+	p := new(AuthorizationFailurePolicy)
+	*p = x
+	return p
+}
+
+func (x AuthorizationFailurePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthorizationFailurePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[12].Descriptor()
+}
+
+func (AuthorizationFailurePolicy) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[12]
+}
+
+func (x AuthorizationFailurePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuthorizationFailurePolicy.Descriptor instead.
+func (AuthorizationFailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{12}
+}
+
+// Scheme of the id generated for the records without a CloudEvent id.
+type IdGenerationStrategy int32
+
+const (
+	// A random UUID.
+	IdGenerationStrategy_ID_UUID IdGenerationStrategy = 0
+	// An id derived from the record topic, partition and offset, so that a record
+	// redelivered after a restart or a rebalance keeps the same id.
+	IdGenerationStrategy_ID_TOPIC_PARTITION_OFFSET IdGenerationStrategy = 1
+)
+
+// Enum value maps for IdGenerationStrategy.
+var (
+	IdGenerationStrategy_name = map[int32]string{
+		0: "ID_UUID",
+		1: "ID_TOPIC_PARTITION_OFFSET",
+	}
+	IdGenerationStrategy_value = map[string]int32{
+		"ID_UUID":                   0,
+		"ID_TOPIC_PARTITION_OFFSET": 1,
+	}
+)
+
+func (x IdGenerationStrategy) Enum() *IdGenerationStrategy {
+	p := new(IdGenerationStrategy)
+	*p = x
+	return p
+}
+
+func (x IdGenerationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdGenerationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[13].Descriptor()
+}
+
+func (IdGenerationStrategy) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[13]
+}
+
+func (x IdGenerationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdGenerationStrategy.Descriptor instead.
+func (IdGenerationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{13}
+}
+
+// HMAC algorithm the events delivered to the subscriber are signed with.
+type SigningAlgorithm int32
+
+const (
+	SigningAlgorithm_HMAC_SHA256 SigningAlgorithm = 0
+	SigningAlgorithm_HMAC_SHA512 SigningAlgorithm = 1
+)
+
+// Enum value maps for SigningAlgorithm.
+var (
+	SigningAlgorithm_name = map[int32]string{
+		0: "HMAC_SHA256",
+		1: "HMAC_SHA512",
+	}
+	SigningAlgorithm_value = map[string]int32{
+		"HMAC_SHA256": 0,
+		"HMAC_SHA512": 1,
+	}
+)
+
+func (x SigningAlgorithm) Enum() *SigningAlgorithm {
+	p := new(SigningAlgorithm)
+	*p = x
+	return p
+}
+
+func (x SigningAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[14].Descriptor()
+}
+
+func (SigningAlgorithm) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[14]
+}
+
+func (x SigningAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningAlgorithm.Descriptor instead.
+func (SigningAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{14}
+}
+
+// Source of the CloudEvent time attribute of the events.
+type TimeSourceType int32
+
+const (
+	// The record timestamp.
+	TimeSourceType_TIME_SOURCE_KAFKA_TIMESTAMP TimeSourceType = 0
+	// The value of a record header.
+	TimeSourceType_TIME_SOURCE_HEADER TimeSourceType = 1
+	// The time the event is dispatched.
+	TimeSourceType_TIME_SOURCE_NOW TimeSourceType = 2
+)
+
+// Enum value maps for TimeSourceType.
+var (
+	TimeSourceType_name = map[int32]string{
+		0: "TIME_SOURCE_KAFKA_TIMESTAMP",
+		1: "TIME_SOURCE_HEADER",
+		2: "TIME_SOURCE_NOW",
+	}
+	TimeSourceType_value = map[string]int32{
+		"TIME_SOURCE_KAFKA_TIMESTAMP": 0,
+		"TIME_SOURCE_HEADER":          1,
+		"TIME_SOURCE_NOW":             2,
+	}
+)
+
+func (x TimeSourceType) Enum() *TimeSourceType {
+	p := new(TimeSourceType)
+	*p = x
+	return p
+}
+
+func (x TimeSourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeSourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[15].Descriptor()
+}
+
+func (TimeSourceType) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[15]
+}
+
+func (x TimeSourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeSourceType.Descriptor instead.
+func (TimeSourceType) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{15}
+}
+
+// Delivery attempts that are audited.
+type AuditLevel int32
+
+const (
+	// Every delivery attempt is audited.
+	AuditLevel_ALL AuditLevel = 0
+	// Only the failed delivery attempts are audited.
+	AuditLevel_FAILURES AuditLevel = 1
+)
+
+// Enum value maps for AuditLevel.
+var (
+	AuditLevel_name = map[int32]string{
+		0: "ALL",
+		1: "FAILURES",
+	}
+	AuditLevel_value = map[string]int32{
+		"ALL":      0,
+		"FAILURES": 1,
+	}
+)
+
+func (x AuditLevel) Enum() *AuditLevel {
+	p := new(AuditLevel)
+	*p = x
+	return p
+}
+
+func (x AuditLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[16].Descriptor()
+}
+
+func (AuditLevel) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[16]
+}
+
+func (x AuditLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLevel.Descriptor instead.
+func (AuditLevel) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{16}
+}
+
+// CloudEvent content mode
+type ContentMode int32
+
+const (
+	ContentMode_BINARY     ContentMode = 0
+	ContentMode_STRUCTURED ContentMode = 1
+)
+
+// Enum value maps for ContentMode.
+var (
+	ContentMode_name = map[int32]string{
+		0: "BINARY",
+		1: "STRUCTURED",
+	}
+	ContentMode_value = map[string]int32{
+		"BINARY":     0,
+		"STRUCTURED": 1,
+	}
+)
+
+func (x ContentMode) Enum() *ContentMode {
+	p := new(ContentMode)
+	*p = x
+	return p
+}
+
+func (x ContentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[17].Descriptor()
+}
+
+func (ContentMode) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[17]
+}
+
+func (x ContentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentMode.Descriptor instead.
+func (ContentMode) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{17}
+}
+
+type SecretField int32
+
+const (
+	SecretField_SASL_MECHANISM SecretField = 0
+	SecretField_CA_CRT         SecretField = 1
+	SecretField_USER_CRT       SecretField = 2
+	SecretField_USER_KEY       SecretField = 3
+	SecretField_USER           SecretField = 4
+	SecretField_PASSWORD       SecretField = 5
+)
+
+// Enum value maps for SecretField.
+var (
+	SecretField_name = map[int32]string{
+		0: "SASL_MECHANISM",
+		1: "CA_CRT",
+		2: "USER_CRT",
+		3: "USER_KEY",
+		4: "USER",
+		5: "PASSWORD",
+	}
+	SecretField_value = map[string]int32{
+		"SASL_MECHANISM": 0,
+		"CA_CRT":         1,
+		"USER_CRT":       2,
+		"USER_KEY":       3,
+		"USER":           4,
+		"PASSWORD":       5,
+	}
+)
+
+func (x SecretField) Enum() *SecretField {
+	p := new(SecretField)
+	*p = x
+	return p
+}
+
+func (x SecretField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretField) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[18].Descriptor()
+}
+
+func (SecretField) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[18]
+}
+
+func (x SecretField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretField.Descriptor instead.
+func (SecretField) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{18}
+}
+
+type Protocol int32
+
+const (
+	Protocol_PLAINTEXT      Protocol = 0
+	Protocol_SASL_PLAINTEXT Protocol = 1
+	Protocol_SSL            Protocol = 2
+	Protocol_SASL_SSL       Protocol = 3
+)
+
+// Enum value maps for Protocol.
+var (
+	Protocol_name = map[int32]string{
+		0: "PLAINTEXT",
+		1: "SASL_PLAINTEXT",
+		2: "SSL",
+		3: "SASL_SSL",
+	}
+	Protocol_value = map[string]int32{
+		"PLAINTEXT":      0,
+		"SASL_PLAINTEXT": 1,
+		"SSL":            2,
+		"SASL_SSL":       3,
+	}
+)
+
+func (x Protocol) Enum() *Protocol {
+	p := new(Protocol)
+	*p = x
+	return p
+}
+
+func (x Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[19].Descriptor()
+}
+
+func (Protocol) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[19]
+}
+
+func (x Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Protocol.Descriptor instead.
+func (Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{19}
+}
+
+// We don't use the google.protobuf.Empty type because
+// configuring the include directory is a mess for the contributors and for the build scripts.
+// Hence, more than dealing with contributors that can't get their dev environment
+// working with the project, we prefer to have this additional single line of code.
+// Protobuf include nightmare? No thanks!
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{0}
+}
+
+type Exact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Exact) Reset() {
+	*x = Exact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Exact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Exact) ProtoMessage() {}
+
+func (x *Exact) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Exact.ProtoReflect.Descriptor instead.
+func (*Exact) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Exact) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Prefix) Reset() {
+	*x = Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prefix) ProtoMessage() {}
+
+func (x *Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
 // Deprecated: Use Prefix.ProtoReflect.Descriptor instead.
@@ -456,38 +1174,916 @@ func (*Prefix) Descriptor() ([]byte, []int) {
 	return file_contract_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Prefix) GetAttributes() map[string]string {
+func (x *Prefix) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type Suffix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Suffix) Reset() {
+	*x = Suffix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suffix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suffix) ProtoMessage() {}
+
+func (x *Suffix) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suffix.ProtoReflect.Descriptor instead.
+func (*Suffix) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Suffix) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type All struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters []*DialectedFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *All) Reset() {
+	*x = All{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *All) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*All) ProtoMessage() {}
+
+func (x *All) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use All.ProtoReflect.Descriptor instead.
+func (*All) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *All) GetFilters() []*DialectedFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type Any struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters []*DialectedFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *Any) Reset() {
+	*x = Any{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Any) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Any) ProtoMessage() {}
+
+func (x *Any) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Any.ProtoReflect.Descriptor instead.
+func (*Any) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Any) GetFilters() []*DialectedFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type Not struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *DialectedFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *Not) Reset() {
+	*x = Not{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Not) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Not) ProtoMessage() {}
+
+func (x *Not) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Not.ProtoReflect.Descriptor instead.
+func (*Not) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Not) GetFilter() *DialectedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CESQL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *CESQL) Reset() {
+	*x = CESQL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CESQL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CESQL) ProtoMessage() {}
+
+func (x *CESQL) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CESQL.ProtoReflect.Descriptor instead.
+func (*CESQL) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CESQL) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type DialectedFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Filter:
+	//
+	//	*DialectedFilter_Exact
+	//	*DialectedFilter_Prefix
+	//	*DialectedFilter_Suffix
+	//	*DialectedFilter_All
+	//	*DialectedFilter_Any
+	//	*DialectedFilter_Not
+	//	*DialectedFilter_Cesql
+	Filter isDialectedFilter_Filter `protobuf_oneof:"filter"`
+}
+
+func (x *DialectedFilter) Reset() {
+	*x = DialectedFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DialectedFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DialectedFilter) ProtoMessage() {}
+
+func (x *DialectedFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DialectedFilter.ProtoReflect.Descriptor instead.
+func (*DialectedFilter) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *DialectedFilter) GetFilter() isDialectedFilter_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *DialectedFilter) GetExact() *Exact {
+	if x, ok := x.GetFilter().(*DialectedFilter_Exact); ok {
+		return x.Exact
+	}
+	return nil
+}
+
+func (x *DialectedFilter) GetPrefix() *Prefix {
+	if x, ok := x.GetFilter().(*DialectedFilter_Prefix); ok {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *DialectedFilter) GetSuffix() *Suffix {
+	if x, ok := x.GetFilter().(*DialectedFilter_Suffix); ok {
+		return x.Suffix
+	}
+	return nil
+}
+
+func (x *DialectedFilter) GetAll() *All {
+	if x, ok := x.GetFilter().(*DialectedFilter_All); ok {
+		return x.All
+	}
+	return nil
+}
+
+func (x *DialectedFilter) GetAny() *Any {
+	if x, ok := x.GetFilter().(*DialectedFilter_Any); ok {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *DialectedFilter) GetNot() *Not {
+	if x, ok := x.GetFilter().(*DialectedFilter_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *DialectedFilter) GetCesql() *CESQL {
+	if x, ok := x.GetFilter().(*DialectedFilter_Cesql); ok {
+		return x.Cesql
+	}
+	return nil
+}
+
+type isDialectedFilter_Filter interface {
+	isDialectedFilter_Filter()
+}
+
+type DialectedFilter_Exact struct {
+	Exact *Exact `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+}
+
+type DialectedFilter_Prefix struct {
+	Prefix *Prefix `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+}
+
+type DialectedFilter_Suffix struct {
+	Suffix *Suffix `protobuf:"bytes,3,opt,name=suffix,proto3,oneof"`
+}
+
+type DialectedFilter_All struct {
+	All *All `protobuf:"bytes,4,opt,name=all,proto3,oneof"`
+}
+
+type DialectedFilter_Any struct {
+	Any *Any `protobuf:"bytes,5,opt,name=any,proto3,oneof"`
+}
+
+type DialectedFilter_Not struct {
+	Not *Not `protobuf:"bytes,6,opt,name=not,proto3,oneof"`
+}
+
+type DialectedFilter_Cesql struct {
+	Cesql *CESQL `protobuf:"bytes,7,opt,name=cesql,proto3,oneof"`
+}
+
+func (*DialectedFilter_Exact) isDialectedFilter_Filter() {}
+
+func (*DialectedFilter_Prefix) isDialectedFilter_Filter() {}
+
+func (*DialectedFilter_Suffix) isDialectedFilter_Filter() {}
+
+func (*DialectedFilter_All) isDialectedFilter_Filter() {}
+
+func (*DialectedFilter_Any) isDialectedFilter_Filter() {}
+
+func (*DialectedFilter_Not) isDialectedFilter_Filter() {}
+
+func (*DialectedFilter_Cesql) isDialectedFilter_Filter() {}
+
+type Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// attributes filters events by exact match on event context attributes.
+	// Each key in the map is compared with the equivalent key in the event
+	// context. An event passes the filter if all values are equal to the
+	// specified values.
+	//
+	// Nested context attributes are not supported as keys. Only string values are supported.
+	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Filter) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type TokenMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Matcher:
+	//
+	//	*TokenMatcher_Exact
+	//	*TokenMatcher_Prefix
+	Matcher isTokenMatcher_Matcher `protobuf_oneof:"matcher"`
+}
+
+func (x *TokenMatcher) Reset() {
+	*x = TokenMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenMatcher) ProtoMessage() {}
+
+func (x *TokenMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenMatcher.ProtoReflect.Descriptor instead.
+func (*TokenMatcher) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *TokenMatcher) GetMatcher() isTokenMatcher_Matcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+func (x *TokenMatcher) GetExact() *Exact {
+	if x, ok := x.GetMatcher().(*TokenMatcher_Exact); ok {
+		return x.Exact
+	}
+	return nil
+}
+
+func (x *TokenMatcher) GetPrefix() *Prefix {
+	if x, ok := x.GetMatcher().(*TokenMatcher_Prefix); ok {
+		return x.Prefix
+	}
+	return nil
+}
+
+type isTokenMatcher_Matcher interface {
+	isTokenMatcher_Matcher()
+}
+
+type TokenMatcher_Exact struct {
+	Exact *Exact `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+}
+
+type TokenMatcher_Prefix struct {
+	Prefix *Prefix `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+}
+
+func (*TokenMatcher_Exact) isTokenMatcher_Matcher() {}
+
+func (*TokenMatcher_Prefix) isTokenMatcher_Matcher() {}
+
+type EventPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Token matchers of this EventPolicy
+	TokenMatchers []*TokenMatcher `protobuf:"bytes,1,rep,name=tokenMatchers,proto3" json:"tokenMatchers,omitempty"`
+	// Filters for this EventPolicy
+	Filters []*DialectedFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *EventPolicy) Reset() {
+	*x = EventPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPolicy) ProtoMessage() {}
+
+func (x *EventPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPolicy.ProtoReflect.Descriptor instead.
+func (*EventPolicy) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventPolicy) GetTokenMatchers() []*TokenMatcher {
+	if x != nil {
+		return x.TokenMatchers
+	}
+	return nil
+}
+
+func (x *EventPolicy) GetFilters() []*DialectedFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type EgressConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dead letter is where the event is sent when something goes wrong
+	DeadLetter string `protobuf:"bytes,1,opt,name=deadLetter,proto3" json:"deadLetter,omitempty"`
+	// Dead Letter CA Cert is the CA Cert used for HTTPS communication through dead letter
+	DeadLetterCACerts string `protobuf:"bytes,6,opt,name=deadLetterCACerts,proto3" json:"deadLetterCACerts,omitempty"`
+	// Dead Letter Audience is the OIDC audience of the dead letter
+	DeadLetterAudience string `protobuf:"bytes,7,opt,name=deadLetterAudience,proto3" json:"deadLetterAudience,omitempty"`
+	// format is the format used to deliver the event. Can be one of "json" or "binary"
+	Format string `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
+	// retry is the minimum number of retries the sender should attempt when
+	// sending an event before moving it to the dead letter sink.
+	//
+	// Setting retry to 0 means don't retry.
+	Retry uint32 `protobuf:"varint,2,opt,name=retry,proto3" json:"retry,omitempty"`
+	// backoffPolicy is the retry backoff policy (linear, exponential).
+	BackoffPolicy BackoffPolicy `protobuf:"varint,3,opt,name=backoffPolicy,proto3,enum=BackoffPolicy" json:"backoffPolicy,omitempty"`
+	// backoffDelay is the delay before retrying in milliseconds.
+	BackoffDelay uint64 `protobuf:"varint,4,opt,name=backoffDelay,proto3" json:"backoffDelay,omitempty"`
+	// timeout is the single request timeout (not the overall retry timeout)
+	Timeout uint64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// retryDeadlineMillis is the time budget in milliseconds for retrying the
+	// delivery of an event before moving it to the dead letter sink.
+	//
+	// When set, it overrides retry: the sender keeps retrying until the deadline
+	// elapses regardless of the number of retries attempted.
+	// Setting retryDeadlineMillis to 0 means the retry count is used.
+	RetryDeadlineMillis uint64 `protobuf:"varint,9,opt,name=retryDeadlineMillis,proto3" json:"retryDeadlineMillis,omitempty"`
+	// When true, the dispatcher pauses consumption, instead of dropping the event,
+	// when the event can't be written to the dead letter sink, and resumes once
+	// the dead letter sink is reachable again.
+	DeadLetterRequired bool `protobuf:"varint,10,opt,name=deadLetterRequired,proto3" json:"deadLetterRequired,omitempty"`
+	// Name of the CloudEvent extension set on the events sent to the dead letter sink
+	// with the reason of the delivery failure (response status code or error).
+	// When empty, no extension is set.
+	DeadLetterReasonExtension string `protobuf:"bytes,11,opt,name=deadLetterReasonExtension,proto3" json:"deadLetterReasonExtension,omitempty"`
+	// When true, the dispatcher sets the originaltopic, originalpartition and originaloffset
+	// extensions, describing the record the event was read from, on the events sent to the
+	// dead letter sink.
+	DeadLetterOriginalMetadata bool `protobuf:"varint,12,opt,name=deadLetterOriginalMetadata,proto3" json:"deadLetterOriginalMetadata,omitempty"`
+	// Maximum number of delivery attempts of an event, counted across retries and reconnects,
+	// after which the event is sent to the dead letter sink, or dropped when there is none.
+	// When 0, the attempts are only bounded by the retry policy.
+	MaxAttempts int32 `protobuf:"varint,13,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	// CloudEvent content mode of the events sent to the dead letter sink,
+	// it's set only when a dead letter sink is configured.
+	DeadLetterContentMode ContentMode `protobuf:"varint,14,opt,name=deadLetterContentMode,proto3,enum=ContentMode" json:"deadLetterContentMode,omitempty"`
+	// Window in milliseconds within which the events with an already delivered id are dropped.
+	// The dispatcher keeps the ids delivered within the window in memory, so the memory it
+	// uses grows with the window and the throughput.
+	// When 0, the events aren't deduplicated.
+	DedupWindowMillis uint64 `protobuf:"varint,15,opt,name=dedupWindowMillis,proto3" json:"dedupWindowMillis,omitempty"`
+	// Handling of the events whose size exceeds oversizeThresholdBytes.
+	OversizeHandling OversizeHandling `protobuf:"varint,16,opt,name=oversizeHandling,proto3,enum=OversizeHandling" json:"oversizeHandling,omitempty"`
+	// Size in bytes above which an event is handled according to oversizeHandling.
+	OversizeThresholdBytes int32 `protobuf:"varint,17,opt,name=oversizeThresholdBytes,proto3" json:"oversizeThresholdBytes,omitempty"`
+	// maxRetryDurationMillis is the wall-clock time in milliseconds after which the
+	// sender stops retrying the delivery of an event, even when retries are left, and
+	// moves it to the dead letter sink.
+	//
+	// Unlike retryDeadlineMillis, it doesn't override retry: the sender stops at the
+	// first of the two limits it reaches.
+	// Setting maxRetryDurationMillis to 0 means the retries are only bounded by retry.
+	MaxRetryDurationMillis uint64 `protobuf:"varint,18,opt,name=maxRetryDurationMillis,proto3" json:"maxRetryDurationMillis,omitempty"`
+}
+
+func (x *EgressConfig) Reset() {
+	*x = EgressConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressConfig) ProtoMessage() {}
+
+func (x *EgressConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressConfig.ProtoReflect.Descriptor instead.
+func (*EgressConfig) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EgressConfig) GetDeadLetter() string {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return ""
+}
+
+func (x *EgressConfig) GetDeadLetterCACerts() string {
+	if x != nil {
+		return x.DeadLetterCACerts
+	}
+	return ""
+}
+
+func (x *EgressConfig) GetDeadLetterAudience() string {
+	if x != nil {
+		return x.DeadLetterAudience
+	}
+	return ""
+}
+
+func (x *EgressConfig) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *EgressConfig) GetRetry() uint32 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *EgressConfig) GetBackoffPolicy() BackoffPolicy {
+	if x != nil {
+		return x.BackoffPolicy
+	}
+	return BackoffPolicy_Exponential
+}
+
+func (x *EgressConfig) GetBackoffDelay() uint64 {
+	if x != nil {
+		return x.BackoffDelay
+	}
+	return 0
+}
+
+func (x *EgressConfig) GetTimeout() uint64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *EgressConfig) GetRetryDeadlineMillis() uint64 {
+	if x != nil {
+		return x.RetryDeadlineMillis
+	}
+	return 0
+}
+
+func (x *EgressConfig) GetDeadLetterRequired() bool {
+	if x != nil {
+		return x.DeadLetterRequired
+	}
+	return false
+}
+
+func (x *EgressConfig) GetDeadLetterReasonExtension() string {
+	if x != nil {
+		return x.DeadLetterReasonExtension
+	}
+	return ""
+}
+
+func (x *EgressConfig) GetDeadLetterOriginalMetadata() bool {
+	if x != nil {
+		return x.DeadLetterOriginalMetadata
+	}
+	return false
+}
+
+func (x *EgressConfig) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *EgressConfig) GetDeadLetterContentMode() ContentMode {
+	if x != nil {
+		return x.DeadLetterContentMode
+	}
+	return ContentMode_BINARY
+}
+
+func (x *EgressConfig) GetDedupWindowMillis() uint64 {
+	if x != nil {
+		return x.DedupWindowMillis
+	}
+	return 0
+}
+
+func (x *EgressConfig) GetOversizeHandling() OversizeHandling {
+	if x != nil {
+		return x.OversizeHandling
+	}
+	return OversizeHandling_OVERSIZE_NONE
+}
+
+func (x *EgressConfig) GetOversizeThresholdBytes() int32 {
+	if x != nil {
+		return x.OversizeThresholdBytes
+	}
+	return 0
+}
+
+func (x *EgressConfig) GetMaxRetryDurationMillis() uint64 {
+	if x != nil {
+		return x.MaxRetryDurationMillis
+	}
+	return 0
+}
+
+// A destination the dispatcher calls on consumer group rebalances.
+type RebalanceCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the callback.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// PEM encoded CA certificates used to verify the callback TLS certificate.
+	DestinationCACerts string `protobuf:"bytes,2,opt,name=destinationCACerts,proto3" json:"destinationCACerts,omitempty"`
+	// OIDC audience of the callback.
+	DestinationAudience string `protobuf:"bytes,3,opt,name=destinationAudience,proto3" json:"destinationAudience,omitempty"`
+}
+
+func (x *RebalanceCallback) Reset() {
+	*x = RebalanceCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceCallback) ProtoMessage() {}
+
+func (x *RebalanceCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceCallback.ProtoReflect.Descriptor instead.
+func (*RebalanceCallback) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RebalanceCallback) GetDestination() string {
 	if x != nil {
-		return x.Attributes
+		return x.Destination
 	}
-	return nil
+	return ""
 }
 
-type Suffix struct {
+func (x *RebalanceCallback) GetDestinationCACerts() string {
+	if x != nil {
+		return x.DestinationCACerts
+	}
+	return ""
+}
+
+func (x *RebalanceCallback) GetDestinationAudience() string {
+	if x != nil {
+		return x.DestinationAudience
+	}
+	return ""
+}
+
+// The external controller pausing and resuming the egress partitions through the dispatcher
+// flow control webhook.
+type FlowControlWebhook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// URL of the external controller.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// PEM encoded CA certificates used to verify the external controller TLS certificate.
+	DestinationCACerts string `protobuf:"bytes,2,opt,name=destinationCACerts,proto3" json:"destinationCACerts,omitempty"`
+	// OIDC audience of the external controller.
+	DestinationAudience string `protobuf:"bytes,3,opt,name=destinationAudience,proto3" json:"destinationAudience,omitempty"`
 }
 
-func (x *Suffix) Reset() {
-	*x = Suffix{}
+func (x *FlowControlWebhook) Reset() {
+	*x = FlowControlWebhook{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[3]
+		mi := &file_contract_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Suffix) String() string {
+func (x *FlowControlWebhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Suffix) ProtoMessage() {}
+func (*FlowControlWebhook) ProtoMessage() {}
 
-func (x *Suffix) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[3]
+func (x *FlowControlWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,43 +2094,64 @@ func (x *Suffix) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Suffix.ProtoReflect.Descriptor instead.
-func (*Suffix) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use FlowControlWebhook.ProtoReflect.Descriptor instead.
+func (*FlowControlWebhook) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Suffix) GetAttributes() map[string]string {
+func (x *FlowControlWebhook) GetDestination() string {
 	if x != nil {
-		return x.Attributes
+		return x.Destination
 	}
-	return nil
+	return ""
 }
 
-type All struct {
+func (x *FlowControlWebhook) GetDestinationCACerts() string {
+	if x != nil {
+		return x.DestinationCACerts
+	}
+	return ""
+}
+
+func (x *FlowControlWebhook) GetDestinationAudience() string {
+	if x != nil {
+		return x.DestinationAudience
+	}
+	return ""
+}
+
+// The HMAC signing of the events delivered to the subscriber, the dispatcher sends the
+// signature of the request body in the X-Signature header as `<algorithm>=<hex encoded HMAC>`.
+type DeliverySigning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filters []*DialectedFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Secret holding the HMAC key, its version changes when the secret is updated.
+	Secret *Reference `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Key of the HMAC key in the secret.
+	SecretKey string `protobuf:"bytes,2,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
+	// HMAC algorithm.
+	Algorithm SigningAlgorithm `protobuf:"varint,3,opt,name=algorithm,proto3,enum=SigningAlgorithm" json:"algorithm,omitempty"`
 }
 
-func (x *All) Reset() {
-	*x = All{}
+func (x *DeliverySigning) Reset() {
+	*x = DeliverySigning{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[4]
+		mi := &file_contract_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *All) String() string {
+func (x *DeliverySigning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*All) ProtoMessage() {}
+func (*DeliverySigning) ProtoMessage() {}
 
-func (x *All) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[4]
+func (x *DeliverySigning) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,43 +2162,60 @@ func (x *All) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use All.ProtoReflect.Descriptor instead.
-func (*All) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DeliverySigning.ProtoReflect.Descriptor instead.
+func (*DeliverySigning) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *All) GetFilters() []*DialectedFilter {
+func (x *DeliverySigning) GetSecret() *Reference {
 	if x != nil {
-		return x.Filters
+		return x.Secret
 	}
 	return nil
 }
 
-type Any struct {
+func (x *DeliverySigning) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *DeliverySigning) GetAlgorithm() SigningAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return SigningAlgorithm_HMAC_SHA256
+}
+
+// Source of the CloudEvent time attribute of the events.
+type TimeSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filters []*DialectedFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	Type TimeSourceType `protobuf:"varint,1,opt,name=type,proto3,enum=TimeSourceType" json:"type,omitempty"`
+	// Name of the record header the time is read from, it's set only with TIME_SOURCE_HEADER.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (x *Any) Reset() {
-	*x = Any{}
+func (x *TimeSource) Reset() {
+	*x = TimeSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[5]
+		mi := &file_contract_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Any) String() string {
+func (x *TimeSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Any) ProtoMessage() {}
+func (*TimeSource) ProtoMessage() {}
 
-func (x *Any) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[5]
+func (x *TimeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,43 +2226,55 @@ func (x *Any) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Any.ProtoReflect.Descriptor instead.
-func (*Any) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TimeSource.ProtoReflect.Descriptor instead.
+func (*TimeSource) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Any) GetFilters() []*DialectedFilter {
+func (x *TimeSource) GetType() TimeSourceType {
 	if x != nil {
-		return x.Filters
+		return x.Type
 	}
-	return nil
+	return TimeSourceType_TIME_SOURCE_KAFKA_TIMESTAMP
 }
 
-type Not struct {
+func (x *TimeSource) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+// Audit records of the delivery attempts, each record has the event ID, the delivery result
+// and the attempt timestamp.
+type Audit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filter *DialectedFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Topic the audit records are written to, using the egress bootstrap servers and auth.
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Delivery attempts that are audited.
+	Level AuditLevel `protobuf:"varint,2,opt,name=level,proto3,enum=AuditLevel" json:"level,omitempty"`
 }
 
-func (x *Not) Reset() {
-	*x = Not{}
+func (x *Audit) Reset() {
+	*x = Audit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[6]
+		mi := &file_contract_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Not) String() string {
+func (x *Audit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Not) ProtoMessage() {}
+func (*Audit) ProtoMessage() {}
 
-func (x *Not) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[6]
+func (x *Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,43 +2285,58 @@ func (x *Not) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Not.ProtoReflect.Descriptor instead.
-func (*Not) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Audit.ProtoReflect.Descriptor instead.
+func (*Audit) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Not) GetFilter() *DialectedFilter {
+func (x *Audit) GetTopic() string {
 	if x != nil {
-		return x.Filter
+		return x.Topic
 	}
-	return nil
+	return ""
 }
 
-type CESQL struct {
+func (x *Audit) GetLevel() AuditLevel {
+	if x != nil {
+		return x.Level
+	}
+	return AuditLevel_ALL
+}
+
+// A destination receiving copies of the events, its delivery outcome doesn't affect the committed offsets.
+type ShadowDelivery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// URL of the shadow destination.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// PEM encoded CA certificates used to verify the shadow destination TLS certificate.
+	DestinationCACerts string `protobuf:"bytes,2,opt,name=destinationCACerts,proto3" json:"destinationCACerts,omitempty"`
+	// OIDC audience of the shadow destination.
+	DestinationAudience string `protobuf:"bytes,3,opt,name=destinationAudience,proto3" json:"destinationAudience,omitempty"`
+	// Percentage, from 0 to 100, of the events sent to the shadow destination.
+	SamplePercent int32 `protobuf:"varint,4,opt,name=samplePercent,proto3" json:"samplePercent,omitempty"`
 }
 
-func (x *CESQL) Reset() {
-	*x = CESQL{}
+func (x *ShadowDelivery) Reset() {
+	*x = ShadowDelivery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[7]
+		mi := &file_contract_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CESQL) String() string {
+func (x *ShadowDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CESQL) ProtoMessage() {}
+func (*ShadowDelivery) ProtoMessage() {}
 
-func (x *CESQL) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[7]
+func (x *ShadowDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,52 +2347,302 @@ func (x *CESQL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CESQL.ProtoReflect.Descriptor instead.
-func (*CESQL) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ShadowDelivery.ProtoReflect.Descriptor instead.
+func (*ShadowDelivery) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CESQL) GetExpression() string {
+func (x *ShadowDelivery) GetDestination() string {
 	if x != nil {
-		return x.Expression
+		return x.Destination
 	}
 	return ""
 }
 
-type DialectedFilter struct {
+func (x *ShadowDelivery) GetDestinationCACerts() string {
+	if x != nil {
+		return x.DestinationCACerts
+	}
+	return ""
+}
+
+func (x *ShadowDelivery) GetDestinationAudience() string {
+	if x != nil {
+		return x.DestinationAudience
+	}
+	return ""
+}
+
+func (x *ShadowDelivery) GetSamplePercent() int32 {
+	if x != nil {
+		return x.SamplePercent
+	}
+	return 0
+}
+
+type Egress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Filter:
+	// consumer group name
+	ConsumerGroup string `protobuf:"bytes,1,opt,name=consumerGroup,proto3" json:"consumerGroup,omitempty"`
+	// destination is the sink where events are sent.
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// destination CA Cert is the CA Cert used for HTTPS communication through destination
+	DestinationCACerts string `protobuf:"bytes,15,opt,name=destinationCACerts,proto3" json:"destinationCACerts,omitempty"`
+	// OIDC audience of the destination
+	DestinationAudience string `protobuf:"bytes,17,opt,name=destinationAudience,proto3" json:"destinationAudience,omitempty"`
+	// Types that are assignable to ReplyStrategy:
 	//
-	//	*DialectedFilter_Exact
-	//	*DialectedFilter_Prefix
-	//	*DialectedFilter_Suffix
-	//	*DialectedFilter_All
-	//	*DialectedFilter_Any
-	//	*DialectedFilter_Not
-	//	*DialectedFilter_Cesql
-	Filter isDialectedFilter_Filter `protobuf_oneof:"filter"`
+	//	*Egress_ReplyUrl
+	//	*Egress_ReplyToOriginalTopic
+	//	*Egress_DiscardReply
+	ReplyStrategy isEgress_ReplyStrategy `protobuf_oneof:"replyStrategy"`
+	// replyUrl CA Cert is the CA Cert used for HTTPS communication through replyUrl
+	ReplyUrlCACerts string `protobuf:"bytes,16,opt,name=replyUrlCACerts,proto3" json:"replyUrlCACerts,omitempty"`
+	// OIDC audience of the replyUrl
+	ReplyUrlAudience string `protobuf:"bytes,18,opt,name=replyUrlAudience,proto3" json:"replyUrlAudience,omitempty"`
+	// A filter for performing exact match against Cloud Events attributes
+	Filter *Filter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Id of the egress
+	// It's the same as the Kubernetes resource uid
+	Uid string `protobuf:"bytes,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Egress configuration.
+	// It overrides Resource's EgressConfig.
+	EgressConfig *EgressConfig `protobuf:"bytes,7,opt,name=egressConfig,proto3" json:"egressConfig,omitempty"`
+	// Delivery guarantee to use
+	// Empty defaults to unordered
+	DeliveryOrder DeliveryOrder `protobuf:"varint,8,opt,name=deliveryOrder,proto3,enum=DeliveryOrder" json:"deliveryOrder,omitempty"`
+	// Kafka record key type.
+	KeyType KeyType `protobuf:"varint,10,opt,name=keyType,proto3,enum=KeyType" json:"keyType,omitempty"`
+	// Resource reference.
+	//
+	// This reference is used to reference the associated resource for data plane
+	// activities such as:
+	// - tagging metrics
+	Reference *Reference `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"`
+	// CNCF CloudEvents SubscriptionsAPI compliant filters
+	DialectedFilter []*DialectedFilter `protobuf:"bytes,12,rep,name=dialectedFilter,proto3" json:"dialectedFilter,omitempty"`
+	// Number of virtual replicas.
+	VReplicas int32 `protobuf:"varint,13,opt,name=vReplicas,proto3" json:"vReplicas,omitempty"`
+	// Egress feature flags.
+	FeatureFlags *EgressFeatureFlags `protobuf:"bytes,14,opt,name=featureFlags,proto3" json:"featureFlags,omitempty"`
+	// Name of the service account to use for OIDC authentication.
+	OidcServiceAccountName string `protobuf:"bytes,19,opt,name=oidcServiceAccountName,proto3" json:"oidcServiceAccountName,omitempty"`
+	// Whether the consumer is allowed to auto-create the topics it subscribes to,
+	// it maps to the Kafka consumer config `allow.auto.create.topics`.
+	//
+	// It defaults to false, which is safer than the Kafka client default.
+	// A topic is auto-created only when both this setting and the broker-side
+	// `auto.create.topics.enable` setting allow it, so a broker that disables
+	// auto-creation always takes precedence over this setting.
+	AllowAutoCreateTopics bool `protobuf:"varint,20,opt,name=allowAutoCreateTopics,proto3" json:"allowAutoCreateTopics,omitempty"`
+	// Topics this egress consumes from, they must be a subset of the resource topics.
+	// When empty, the egress consumes from all the resource topics.
+	Topics []string `protobuf:"bytes,21,rep,name=topics,proto3" json:"topics,omitempty"`
+	// Headers to strip from the events sent to the destination, header names are
+	// case-insensitive.
+	// They're stripped after any other header manipulation, right before sending the event.
+	StripHeaders []string `protobuf:"bytes,22,rep,name=stripHeaders,proto3" json:"stripHeaders,omitempty"`
+	// Whether the dispatcher should set the `knativekafkaattempts` extension on the events
+	// sent to the destination, its value is the number of attempts it took to deliver the event.
+	EmitDeliveryAttempts bool `protobuf:"varint,23,opt,name=emitDeliveryAttempts,proto3" json:"emitDeliveryAttempts,omitempty"`
+	// How to handle a reply that isn't a valid CloudEvent, it's used only
+	// with the replyUrl and replyToOriginalTopic reply strategies.
+	OnMalformedReply MalformedReply `protobuf:"varint,24,opt,name=onMalformedReply,proto3,enum=MalformedReply" json:"onMalformedReply,omitempty"`
+	// The backoff before reconnecting to a broker, it maps to the Kafka consumer
+	// config `reconnect.backoff.ms`.
+	ReconnectBackoffMillis uint64 `protobuf:"varint,25,opt,name=reconnectBackoffMillis,proto3" json:"reconnectBackoffMillis,omitempty"`
+	// The maximum backoff before reconnecting to a broker that repeatedly failed to connect,
+	// it maps to the Kafka consumer config `reconnect.backoff.max.ms`.
+	ReconnectBackoffMaxMillis uint64 `protobuf:"varint,26,opt,name=reconnectBackoffMaxMillis,proto3" json:"reconnectBackoffMaxMillis,omitempty"`
+	// The User-Agent header of the HTTP requests sent to the destination,
+	// when empty the dispatcher default User-Agent is used.
+	UserAgent string `protobuf:"bytes,27,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	// The maximum bytes returned by a fetch request, it maps to the Kafka consumer
+	// config `fetch.max.bytes`.
+	// When zero, the Kafka client default is used.
+	FetchMaxBytes int32 `protobuf:"varint,28,opt,name=fetchMaxBytes,proto3" json:"fetchMaxBytes,omitempty"`
+	// The size of the TCP receive buffer, it maps to the Kafka consumer
+	// config `receive.buffer.bytes`.
+	// When zero, the Kafka client default is used.
+	ReceiveBufferBytes int32 `protobuf:"varint,29,opt,name=receiveBufferBytes,proto3" json:"receiveBufferBytes,omitempty"`
+	// The CloudEvents content mode of the events sent to the destination.
+	ContentMode ContentMode `protobuf:"varint,30,opt,name=contentMode,proto3,enum=ContentMode" json:"contentMode,omitempty"`
+	// When greater than vReplicas, the number of virtual replicas was decreased from this value
+	// and the dispatcher should gracefully revoke the partitions of the removed executors,
+	// waiting for in-flight events and committing their offsets, before stopping them.
+	DrainFromVReplicas int32 `protobuf:"varint,31,opt,name=drainFromVReplicas,proto3" json:"drainFromVReplicas,omitempty"`
+	// Maximum age, in milliseconds, of the topic metadata before the dispatcher refreshes it
+	// and notices new partitions, set from the `metadata.max.age.ms` consumer config.
+	// When 0, the Kafka client default is used.
+	MetadataMaxAgeMillis int64 `protobuf:"varint,32,opt,name=metadataMaxAgeMillis,proto3" json:"metadataMaxAgeMillis,omitempty"`
+	// Top-level user-facing resource this egress originates from, for example the Broker of a Trigger,
+	// it allows grouping per-egress metrics by originating resource.
+	OriginRef *Reference `protobuf:"bytes,33,opt,name=originRef,proto3" json:"originRef,omitempty"`
+	// Callback called before partitions are revoked from this egress, for example to flush state.
+	// When absent, no callback is called.
+	OnPartitionsRevoked *RebalanceCallback `protobuf:"bytes,34,opt,name=onPartitionsRevoked,proto3" json:"onPartitionsRevoked,omitempty"`
+	// Callback called after partitions are assigned to this egress.
+	// When absent, no callback is called.
+	OnPartitionsAssigned *RebalanceCallback `protobuf:"bytes,35,opt,name=onPartitionsAssigned,proto3" json:"onPartitionsAssigned,omitempty"`
+	// Partition assignment strategy, it defaults to cooperative sticky.
+	PartitionAssignor PartitionAssignor `protobuf:"varint,36,opt,name=partitionAssignor,proto3,enum=PartitionAssignor" json:"partitionAssignor,omitempty"`
+	// Expected throughput in events per second declared by the user, it informs scheduling
+	// decisions and doesn't change the delivery.
+	// When 0, the expected throughput is unknown.
+	ExpectedThroughput int32 `protobuf:"varint,37,opt,name=expectedThroughput,proto3" json:"expectedThroughput,omitempty"`
+	// Rack of the consumer, when set the dispatcher enables fetching from the closest replica
+	// of the brokers in the same rack.
+	ClientRack string `protobuf:"bytes,38,opt,name=clientRack,proto3" json:"clientRack,omitempty"`
+	// Interval in milliseconds between the periodic commits of the consumed offsets.
+	CommitIntervalMillis uint64 `protobuf:"varint,39,opt,name=commitIntervalMillis,proto3" json:"commitIntervalMillis,omitempty"`
+	// When true, the dispatcher follows a bounded number of redirect responses from the destination,
+	// otherwise they're treated as delivery failures.
+	FollowRedirects bool `protobuf:"varint,40,opt,name=followRedirects,proto3" json:"followRedirects,omitempty"`
+	// Number of consecutive delivery failures, across all events, after which the dispatcher
+	// opens the circuit and pauses the delivery.
+	// When 0, the circuit breaker is disabled.
+	CircuitBreakerFailureThreshold uint32 `protobuf:"varint,41,opt,name=circuitBreakerFailureThreshold,proto3" json:"circuitBreakerFailureThreshold,omitempty"`
+	// Time in milliseconds the delivery pauses once the circuit opens.
+	CircuitBreakerPauseMillis uint64 `protobuf:"varint,42,opt,name=circuitBreakerPauseMillis,proto3" json:"circuitBreakerPauseMillis,omitempty"`
+	// Consumer lag each replica is expected to handle, the dispatcher or an autoscaler
+	// derive the desired scale from the lag divided by this target.
+	// When 0, no lag based scale hint is given.
+	LagScaleTarget int32 `protobuf:"varint,43,opt,name=lagScaleTarget,proto3" json:"lagScaleTarget,omitempty"`
+	// CloudEvent attribute used as the key of the records produced by replyToOriginalTopic.
+	// When empty, the key of the original record is used.
+	ReplyKeyAttribute string `protobuf:"bytes,44,opt,name=replyKeyAttribute,proto3" json:"replyKeyAttribute,omitempty"`
+	// When true, the dispatcher stops fetching new records for the egress and, once its in-flight
+	// events are completed, reports it through the drained consumers annotation of its pod.
+	Draining bool `protobuf:"varint,45,opt,name=draining,proto3" json:"draining,omitempty"`
+	// When set, the dispatcher sends a copy of each event to the shadow destination, ignoring
+	// the delivery outcome and the reply, so that the committed offset only depends on the delivery
+	// to the destination.
+	ShadowDelivery *ShadowDelivery `protobuf:"bytes,46,opt,name=shadowDelivery,proto3" json:"shadowDelivery,omitempty"`
+	// Maximum time in milliseconds a fetch request waits on the Kafka broker for data.
+	FetchMaxWaitMillis uint64 `protobuf:"varint,47,opt,name=fetchMaxWaitMillis,proto3" json:"fetchMaxWaitMillis,omitempty"`
+	// Maximum number of concurrent HTTP connections the dispatcher opens to the subscriber.
+	// When 0, the dispatcher default is used.
+	SubscriberMaxConns int32 `protobuf:"varint,48,opt,name=subscriberMaxConns,proto3" json:"subscriberMaxConns,omitempty"`
+	// Maximum number of partitions the dispatcher fetches from in parallel, events of the same
+	// partition are still fetched sequentially so ordered delivery keeps the per-partition order.
+	// When 0, the dispatcher default is used.
+	FetchConcurrency int32 `protobuf:"varint,49,opt,name=fetchConcurrency,proto3" json:"fetchConcurrency,omitempty"`
+	// CloudEvent extensions an event must have to be sent to the subscriber.
+	RequiredExtensions []string `protobuf:"bytes,50,rep,name=requiredExtensions,proto3" json:"requiredExtensions,omitempty"`
+	// When true, the events missing any of the required extensions are discarded,
+	// otherwise they are sent to the dead letter sink.
+	DropMissingExtensions bool `protobuf:"varint,51,opt,name=dropMissingExtensions,proto3" json:"dropMissingExtensions,omitempty"`
+	// When true, the dispatcher delays the next delivery by the Retry-After header value
+	// returned by the destination, up to a cap.
+	HonorRetryAfter bool `protobuf:"varint,52,opt,name=honorRetryAfter,proto3" json:"honorRetryAfter,omitempty"`
+	// When set, the dispatcher writes an audit record for the delivery attempts.
+	Audit *Audit `protobuf:"bytes,53,opt,name=audit,proto3" json:"audit,omitempty"`
+	// Time in milliseconds the dispatcher waits for the consumer group coordinator to be discovered,
+	// set from the `coordinator.discovery.timeout.ms` consumer config.
+	// When 0, the dispatcher default is used.
+	CoordinatorDiscoveryTimeoutMillis int64 `protobuf:"varint,54,opt,name=coordinatorDiscoveryTimeoutMillis,proto3" json:"coordinatorDiscoveryTimeoutMillis,omitempty"`
+	// Format of the payload sent to the destination.
+	PayloadFormat PayloadFormat `protobuf:"varint,55,opt,name=payloadFormat,proto3,enum=PayloadFormat" json:"payloadFormat,omitempty"`
+	// Maximum time in milliseconds the dispatcher waits, on shutdown, for the in-flight events
+	// to be delivered and their offsets committed.
+	// When 0, the dispatcher default is used.
+	ShutdownGraceMillis uint64 `protobuf:"varint,56,opt,name=shutdownGraceMillis,proto3" json:"shutdownGraceMillis,omitempty"`
+	// URL of the proxy (http, https or socks5) the events are sent to the destination through.
+	// When empty, the events are sent directly to the destination.
+	DeliveryProxy string `protobuf:"bytes,57,opt,name=deliveryProxy,proto3" json:"deliveryProxy,omitempty"`
+	// Whether the dispatcher stops committing the offsets periodically, it maps to the
+	// Kafka consumer config `enable.auto.commit` set to false.
+	//
+	// When true, an offset is committed only once the events before it are delivered, so
+	// a restart or a rebalance redelivers every event whose offset isn't committed yet.
+	// It defaults to false, which preserves the periodic commit.
+	DisableAutoCommit bool `protobuf:"varint,58,opt,name=disableAutoCommit,proto3" json:"disableAutoCommit,omitempty"`
+	// Handling of the records without a key, which have no defined order
+	// when the delivery is key ordered.
+	NullKeyPolicy NullKeyPolicy `protobuf:"varint,59,opt,name=nullKeyPolicy,proto3,enum=NullKeyPolicy" json:"nullKeyPolicy,omitempty"`
+	// Timeout in milliseconds of each request sent to the destination, it's distinct from
+	// the egress config timeout, which comes from the delivery spec.
+	// When 0, the egress config timeout is used.
+	RequestTimeoutMillis uint64 `protobuf:"varint,60,opt,name=requestTimeoutMillis,proto3" json:"requestTimeoutMillis,omitempty"`
+	// Scheme of the id generated for the records without a CloudEvent id.
+	IdGenerationStrategy IdGenerationStrategy `protobuf:"varint,61,opt,name=idGenerationStrategy,proto3,enum=IdGenerationStrategy" json:"idGenerationStrategy,omitempty"`
+	// Number of consecutive deserialization failures of the records of a partition
+	// after which the dispatcher pauses the partition.
+	// When 0, the partition is never paused.
+	MaxConsecutiveDeserFailures int32 `protobuf:"varint,62,opt,name=maxConsecutiveDeserFailures,proto3" json:"maxConsecutiveDeserFailures,omitempty"`
+	// Time in milliseconds an idle connection to the destination is kept open for reuse.
+	// When 0, idle connections are closed right away.
+	KeepAliveMillis uint64 `protobuf:"varint,63,opt,name=keepAliveMillis,proto3" json:"keepAliveMillis,omitempty"`
+	// Maximum number of idle connections to the destination kept open for reuse.
+	// When 0, the dispatcher default is used.
+	SubscriberMaxIdleConns int32 `protobuf:"varint,64,opt,name=subscriberMaxIdleConns,proto3" json:"subscriberMaxIdleConns,omitempty"`
+	// Reaction to the deletion of one of the topics.
+	TopicDeletedPolicy TopicDeletedPolicy `protobuf:"varint,65,opt,name=topicDeletedPolicy,proto3,enum=TopicDeletedPolicy" json:"topicDeletedPolicy,omitempty"`
+	// Percentage, from 0 to 100, of the delivered events delivery metrics are recorded for.
+	MetricsSampleRate int32 `protobuf:"varint,66,opt,name=metricsSampleRate,proto3" json:"metricsSampleRate,omitempty"`
+	// Delivery of the events that can't be delivered in order, it's set only with ordered delivery.
+	OrderingFallback OrderingFallback `protobuf:"varint,67,opt,name=orderingFallback,proto3,enum=OrderingFallback" json:"orderingFallback,omitempty"`
+	// Handling of the tombstones, the records without a value.
+	TombstonePolicy TombstonePolicy `protobuf:"varint,68,opt,name=tombstonePolicy,proto3,enum=TombstonePolicy" json:"tombstonePolicy,omitempty"`
+	// When the offset of an event is committed.
+	CommitMode CommitMode `protobuf:"varint,69,opt,name=commitMode,proto3,enum=CommitMode" json:"commitMode,omitempty"`
+	// Maximum time to process the polled events before the consumer leaves the consumer group,
+	// 0 means the Kafka client default.
+	RebalanceTimeoutMillis uint64 `protobuf:"varint,70,opt,name=rebalanceTimeoutMillis,proto3" json:"rebalanceTimeoutMillis,omitempty"`
+	// Bootstrap servers of the Kafka cluster the replies are produced to, when they're produced
+	// to the original topic.
+	// When empty, the replies are produced to the Kafka cluster of the resource.
+	ReplyBootstrapServers string `protobuf:"bytes,71,opt,name=replyBootstrapServers,proto3" json:"replyBootstrapServers,omitempty"`
+	// Auth of the Kafka cluster the replies are produced to, it's set only with replyBootstrapServers.
+	ReplyAuth *MultiSecretReference `protobuf:"bytes,72,opt,name=replyAuth,proto3" json:"replyAuth,omitempty"`
+	// Name of the record header whose value is used as the ordering key, it's set only with
+	// ordered delivery.
+	// When empty, the record key is used.
+	OrderingKeyHeader string `protobuf:"bytes,73,opt,name=orderingKeyHeader,proto3" json:"orderingKeyHeader,omitempty"`
+	// Source of the CloudEvent time attribute of the events.
+	// When unset, the record timestamp is used.
+	TimeSource *TimeSource `protobuf:"bytes,74,opt,name=timeSource,proto3" json:"timeSource,omitempty"`
+	// Time in milliseconds without a successful delivery, while the consumer has lag, after which
+	// the dispatcher reports the egress delivery as stalled.
+	// When 0, the stall detection is disabled.
+	DeliveryStallWindowMillis uint64 `protobuf:"varint,75,opt,name=deliveryStallWindowMillis,proto3" json:"deliveryStallWindowMillis,omitempty"`
+	// External controller pausing and resuming the partitions, when unset the partitions are
+	// paused and resumed by the dispatcher only.
+	FlowControlWebhook *FlowControlWebhook `protobuf:"bytes,76,opt,name=flowControlWebhook,proto3" json:"flowControlWebhook,omitempty"`
+	// HMAC signing of the events delivered to the subscriber, when unset the events aren't signed.
+	Signing *DeliverySigning `protobuf:"bytes,77,opt,name=signing,proto3" json:"signing,omitempty"`
+	// Secret, of type kubernetes.io/tls, holding the client certificate and key, in the tls.crt and
+	// tls.key keys, presented to the destination for mutual TLS, its version changes when the secret
+	// is updated. When unset, no client certificate is presented.
+	DestinationClientCert *Reference `protobuf:"bytes,78,opt,name=destinationClientCert,proto3" json:"destinationClientCert,omitempty"`
+	// Maximum number of producers concurrently producing the replies, it's set only with
+	// replyToOriginalTopic.
+	// When 0, the number of reply producers is unbounded.
+	ReplyMaxProducers uint32 `protobuf:"varint,79,opt,name=replyMaxProducers,proto3" json:"replyMaxProducers,omitempty"`
+	// Reaction to the loss of access to the consumer group.
+	AuthorizationFailurePolicy AuthorizationFailurePolicy `protobuf:"varint,80,opt,name=authorizationFailurePolicy,proto3,enum=AuthorizationFailurePolicy" json:"authorizationFailurePolicy,omitempty"`
 }
 
-func (x *DialectedFilter) Reset() {
-	*x = DialectedFilter{}
+func (x *Egress) Reset() {
+	*x = Egress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[8]
+		mi := &file_contract_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DialectedFilter) String() string {
+func (x *Egress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DialectedFilter) ProtoMessage() {}
-
-func (x *DialectedFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[8]
+func (*Egress) ProtoMessage() {}
+
+func (x *Egress) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,640 +2653,576 @@ func (x *DialectedFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DialectedFilter.ProtoReflect.Descriptor instead.
-func (*DialectedFilter) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Egress.ProtoReflect.Descriptor instead.
+func (*Egress) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *DialectedFilter) GetFilter() isDialectedFilter_Filter {
-	if m != nil {
-		return m.Filter
+func (x *Egress) GetConsumerGroup() string {
+	if x != nil {
+		return x.ConsumerGroup
 	}
-	return nil
+	return ""
 }
 
-func (x *DialectedFilter) GetExact() *Exact {
-	if x, ok := x.GetFilter().(*DialectedFilter_Exact); ok {
-		return x.Exact
+func (x *Egress) GetDestination() string {
+	if x != nil {
+		return x.Destination
 	}
-	return nil
+	return ""
 }
 
-func (x *DialectedFilter) GetPrefix() *Prefix {
-	if x, ok := x.GetFilter().(*DialectedFilter_Prefix); ok {
-		return x.Prefix
+func (x *Egress) GetDestinationCACerts() string {
+	if x != nil {
+		return x.DestinationCACerts
 	}
-	return nil
+	return ""
 }
 
-func (x *DialectedFilter) GetSuffix() *Suffix {
-	if x, ok := x.GetFilter().(*DialectedFilter_Suffix); ok {
-		return x.Suffix
+func (x *Egress) GetDestinationAudience() string {
+	if x != nil {
+		return x.DestinationAudience
 	}
-	return nil
+	return ""
 }
 
-func (x *DialectedFilter) GetAll() *All {
-	if x, ok := x.GetFilter().(*DialectedFilter_All); ok {
-		return x.All
+func (m *Egress) GetReplyStrategy() isEgress_ReplyStrategy {
+	if m != nil {
+		return m.ReplyStrategy
 	}
 	return nil
 }
 
-func (x *DialectedFilter) GetAny() *Any {
-	if x, ok := x.GetFilter().(*DialectedFilter_Any); ok {
-		return x.Any
+func (x *Egress) GetReplyUrl() string {
+	if x, ok := x.GetReplyStrategy().(*Egress_ReplyUrl); ok {
+		return x.ReplyUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *DialectedFilter) GetNot() *Not {
-	if x, ok := x.GetFilter().(*DialectedFilter_Not); ok {
-		return x.Not
+func (x *Egress) GetReplyToOriginalTopic() *Empty {
+	if x, ok := x.GetReplyStrategy().(*Egress_ReplyToOriginalTopic); ok {
+		return x.ReplyToOriginalTopic
 	}
 	return nil
 }
 
-func (x *DialectedFilter) GetCesql() *CESQL {
-	if x, ok := x.GetFilter().(*DialectedFilter_Cesql); ok {
-		return x.Cesql
+func (x *Egress) GetDiscardReply() *Empty {
+	if x, ok := x.GetReplyStrategy().(*Egress_DiscardReply); ok {
+		return x.DiscardReply
 	}
 	return nil
 }
 
-type isDialectedFilter_Filter interface {
-	isDialectedFilter_Filter()
+func (x *Egress) GetReplyUrlCACerts() string {
+	if x != nil {
+		return x.ReplyUrlCACerts
+	}
+	return ""
 }
 
-type DialectedFilter_Exact struct {
-	Exact *Exact `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+func (x *Egress) GetReplyUrlAudience() string {
+	if x != nil {
+		return x.ReplyUrlAudience
+	}
+	return ""
 }
 
-type DialectedFilter_Prefix struct {
-	Prefix *Prefix `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+func (x *Egress) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-type DialectedFilter_Suffix struct {
-	Suffix *Suffix `protobuf:"bytes,3,opt,name=suffix,proto3,oneof"`
+func (x *Egress) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
 }
 
-type DialectedFilter_All struct {
-	All *All `protobuf:"bytes,4,opt,name=all,proto3,oneof"`
+func (x *Egress) GetEgressConfig() *EgressConfig {
+	if x != nil {
+		return x.EgressConfig
+	}
+	return nil
 }
 
-type DialectedFilter_Any struct {
-	Any *Any `protobuf:"bytes,5,opt,name=any,proto3,oneof"`
+func (x *Egress) GetDeliveryOrder() DeliveryOrder {
+	if x != nil {
+		return x.DeliveryOrder
+	}
+	return DeliveryOrder_UNORDERED
 }
 
-type DialectedFilter_Not struct {
-	Not *Not `protobuf:"bytes,6,opt,name=not,proto3,oneof"`
+func (x *Egress) GetKeyType() KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return KeyType_String
 }
 
-type DialectedFilter_Cesql struct {
-	Cesql *CESQL `protobuf:"bytes,7,opt,name=cesql,proto3,oneof"`
+func (x *Egress) GetReference() *Reference {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
 }
 
-func (*DialectedFilter_Exact) isDialectedFilter_Filter() {}
-
-func (*DialectedFilter_Prefix) isDialectedFilter_Filter() {}
-
-func (*DialectedFilter_Suffix) isDialectedFilter_Filter() {}
-
-func (*DialectedFilter_All) isDialectedFilter_Filter() {}
-
-func (*DialectedFilter_Any) isDialectedFilter_Filter() {}
-
-func (*DialectedFilter_Not) isDialectedFilter_Filter() {}
-
-func (*DialectedFilter_Cesql) isDialectedFilter_Filter() {}
+func (x *Egress) GetDialectedFilter() []*DialectedFilter {
+	if x != nil {
+		return x.DialectedFilter
+	}
+	return nil
+}
 
-type Filter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Egress) GetVReplicas() int32 {
+	if x != nil {
+		return x.VReplicas
+	}
+	return 0
+}
 
-	// attributes filters events by exact match on event context attributes.
-	// Each key in the map is compared with the equivalent key in the event
-	// context. An event passes the filter if all values are equal to the
-	// specified values.
-	//
-	// Nested context attributes are not supported as keys. Only string values are supported.
-	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *Egress) GetFeatureFlags() *EgressFeatureFlags {
+	if x != nil {
+		return x.FeatureFlags
+	}
+	return nil
 }
 
-func (x *Filter) Reset() {
-	*x = Filter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Egress) GetOidcServiceAccountName() string {
+	if x != nil {
+		return x.OidcServiceAccountName
 	}
+	return ""
 }
 
-func (x *Filter) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Egress) GetAllowAutoCreateTopics() bool {
+	if x != nil {
+		return x.AllowAutoCreateTopics
+	}
+	return false
 }
 
-func (*Filter) ProtoMessage() {}
+func (x *Egress) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
 
-func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Egress) GetStripHeaders() []string {
+	if x != nil {
+		return x.StripHeaders
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
-func (*Filter) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{9}
+func (x *Egress) GetEmitDeliveryAttempts() bool {
+	if x != nil {
+		return x.EmitDeliveryAttempts
+	}
+	return false
 }
 
-func (x *Filter) GetAttributes() map[string]string {
+func (x *Egress) GetOnMalformedReply() MalformedReply {
 	if x != nil {
-		return x.Attributes
+		return x.OnMalformedReply
 	}
-	return nil
+	return MalformedReply_ERROR
 }
 
-type TokenMatcher struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Egress) GetReconnectBackoffMillis() uint64 {
+	if x != nil {
+		return x.ReconnectBackoffMillis
+	}
+	return 0
+}
 
-	// Types that are assignable to Matcher:
-	//
-	//	*TokenMatcher_Exact
-	//	*TokenMatcher_Prefix
-	Matcher isTokenMatcher_Matcher `protobuf_oneof:"matcher"`
+func (x *Egress) GetReconnectBackoffMaxMillis() uint64 {
+	if x != nil {
+		return x.ReconnectBackoffMaxMillis
+	}
+	return 0
 }
 
-func (x *TokenMatcher) Reset() {
-	*x = TokenMatcher{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Egress) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
 	}
+	return ""
 }
 
-func (x *TokenMatcher) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Egress) GetFetchMaxBytes() int32 {
+	if x != nil {
+		return x.FetchMaxBytes
+	}
+	return 0
 }
 
-func (*TokenMatcher) ProtoMessage() {}
+func (x *Egress) GetReceiveBufferBytes() int32 {
+	if x != nil {
+		return x.ReceiveBufferBytes
+	}
+	return 0
+}
 
-func (x *TokenMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Egress) GetContentMode() ContentMode {
+	if x != nil {
+		return x.ContentMode
 	}
-	return mi.MessageOf(x)
+	return ContentMode_BINARY
 }
 
-// Deprecated: Use TokenMatcher.ProtoReflect.Descriptor instead.
-func (*TokenMatcher) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{10}
+func (x *Egress) GetDrainFromVReplicas() int32 {
+	if x != nil {
+		return x.DrainFromVReplicas
+	}
+	return 0
 }
 
-func (m *TokenMatcher) GetMatcher() isTokenMatcher_Matcher {
-	if m != nil {
-		return m.Matcher
+func (x *Egress) GetMetadataMaxAgeMillis() int64 {
+	if x != nil {
+		return x.MetadataMaxAgeMillis
 	}
-	return nil
+	return 0
 }
 
-func (x *TokenMatcher) GetExact() *Exact {
-	if x, ok := x.GetMatcher().(*TokenMatcher_Exact); ok {
-		return x.Exact
+func (x *Egress) GetOriginRef() *Reference {
+	if x != nil {
+		return x.OriginRef
 	}
 	return nil
 }
 
-func (x *TokenMatcher) GetPrefix() *Prefix {
-	if x, ok := x.GetMatcher().(*TokenMatcher_Prefix); ok {
-		return x.Prefix
+func (x *Egress) GetOnPartitionsRevoked() *RebalanceCallback {
+	if x != nil {
+		return x.OnPartitionsRevoked
 	}
 	return nil
 }
 
-type isTokenMatcher_Matcher interface {
-	isTokenMatcher_Matcher()
+func (x *Egress) GetOnPartitionsAssigned() *RebalanceCallback {
+	if x != nil {
+		return x.OnPartitionsAssigned
+	}
+	return nil
 }
 
-type TokenMatcher_Exact struct {
-	Exact *Exact `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+func (x *Egress) GetPartitionAssignor() PartitionAssignor {
+	if x != nil {
+		return x.PartitionAssignor
+	}
+	return PartitionAssignor_COOPERATIVE_STICKY
 }
 
-type TokenMatcher_Prefix struct {
-	Prefix *Prefix `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+func (x *Egress) GetExpectedThroughput() int32 {
+	if x != nil {
+		return x.ExpectedThroughput
+	}
+	return 0
 }
 
-func (*TokenMatcher_Exact) isTokenMatcher_Matcher() {}
-
-func (*TokenMatcher_Prefix) isTokenMatcher_Matcher() {}
-
-type EventPolicy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Token matchers of this EventPolicy
-	TokenMatchers []*TokenMatcher `protobuf:"bytes,1,rep,name=tokenMatchers,proto3" json:"tokenMatchers,omitempty"`
-	// Filters for this EventPolicy
-	Filters []*DialectedFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+func (x *Egress) GetClientRack() string {
+	if x != nil {
+		return x.ClientRack
+	}
+	return ""
 }
 
-func (x *EventPolicy) Reset() {
-	*x = EventPolicy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Egress) GetCommitIntervalMillis() uint64 {
+	if x != nil {
+		return x.CommitIntervalMillis
 	}
+	return 0
 }
 
-func (x *EventPolicy) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Egress) GetFollowRedirects() bool {
+	if x != nil {
+		return x.FollowRedirects
+	}
+	return false
 }
 
-func (*EventPolicy) ProtoMessage() {}
-
-func (x *EventPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Egress) GetCircuitBreakerFailureThreshold() uint32 {
+	if x != nil {
+		return x.CircuitBreakerFailureThreshold
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use EventPolicy.ProtoReflect.Descriptor instead.
-func (*EventPolicy) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{11}
+func (x *Egress) GetCircuitBreakerPauseMillis() uint64 {
+	if x != nil {
+		return x.CircuitBreakerPauseMillis
+	}
+	return 0
 }
 
-func (x *EventPolicy) GetTokenMatchers() []*TokenMatcher {
+func (x *Egress) GetLagScaleTarget() int32 {
 	if x != nil {
-		return x.TokenMatchers
+		return x.LagScaleTarget
 	}
-	return nil
+	return 0
 }
 
-func (x *EventPolicy) GetFilters() []*DialectedFilter {
+func (x *Egress) GetReplyKeyAttribute() string {
 	if x != nil {
-		return x.Filters
+		return x.ReplyKeyAttribute
 	}
-	return nil
+	return ""
 }
 
-type EgressConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Dead letter is where the event is sent when something goes wrong
-	DeadLetter string `protobuf:"bytes,1,opt,name=deadLetter,proto3" json:"deadLetter,omitempty"`
-	// Dead Letter CA Cert is the CA Cert used for HTTPS communication through dead letter
-	DeadLetterCACerts string `protobuf:"bytes,6,opt,name=deadLetterCACerts,proto3" json:"deadLetterCACerts,omitempty"`
-	// Dead Letter Audience is the OIDC audience of the dead letter
-	DeadLetterAudience string `protobuf:"bytes,7,opt,name=deadLetterAudience,proto3" json:"deadLetterAudience,omitempty"`
-	// format is the format used to deliver the event. Can be one of "json" or "binary"
-	Format string `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
-	// retry is the minimum number of retries the sender should attempt when
-	// sending an event before moving it to the dead letter sink.
-	//
-	// Setting retry to 0 means don't retry.
-	Retry uint32 `protobuf:"varint,2,opt,name=retry,proto3" json:"retry,omitempty"`
-	// backoffPolicy is the retry backoff policy (linear, exponential).
-	BackoffPolicy BackoffPolicy `protobuf:"varint,3,opt,name=backoffPolicy,proto3,enum=BackoffPolicy" json:"backoffPolicy,omitempty"`
-	// backoffDelay is the delay before retrying in milliseconds.
-	BackoffDelay uint64 `protobuf:"varint,4,opt,name=backoffDelay,proto3" json:"backoffDelay,omitempty"`
-	// timeout is the single request timeout (not the overall retry timeout)
-	Timeout uint64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+func (x *Egress) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
 }
 
-func (x *EgressConfig) Reset() {
-	*x = EgressConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Egress) GetShadowDelivery() *ShadowDelivery {
+	if x != nil {
+		return x.ShadowDelivery
 	}
+	return nil
 }
 
-func (x *EgressConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Egress) GetFetchMaxWaitMillis() uint64 {
+	if x != nil {
+		return x.FetchMaxWaitMillis
+	}
+	return 0
 }
 
-func (*EgressConfig) ProtoMessage() {}
-
-func (x *EgressConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Egress) GetSubscriberMaxConns() int32 {
+	if x != nil {
+		return x.SubscriberMaxConns
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use EgressConfig.ProtoReflect.Descriptor instead.
-func (*EgressConfig) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{12}
+func (x *Egress) GetFetchConcurrency() int32 {
+	if x != nil {
+		return x.FetchConcurrency
+	}
+	return 0
 }
 
-func (x *EgressConfig) GetDeadLetter() string {
+func (x *Egress) GetRequiredExtensions() []string {
 	if x != nil {
-		return x.DeadLetter
+		return x.RequiredExtensions
 	}
-	return ""
+	return nil
 }
 
-func (x *EgressConfig) GetDeadLetterCACerts() string {
+func (x *Egress) GetDropMissingExtensions() bool {
 	if x != nil {
-		return x.DeadLetterCACerts
+		return x.DropMissingExtensions
 	}
-	return ""
+	return false
 }
 
-func (x *EgressConfig) GetDeadLetterAudience() string {
+func (x *Egress) GetHonorRetryAfter() bool {
 	if x != nil {
-		return x.DeadLetterAudience
+		return x.HonorRetryAfter
 	}
-	return ""
+	return false
 }
 
-func (x *EgressConfig) GetFormat() string {
+func (x *Egress) GetAudit() *Audit {
 	if x != nil {
-		return x.Format
+		return x.Audit
 	}
-	return ""
+	return nil
 }
 
-func (x *EgressConfig) GetRetry() uint32 {
+func (x *Egress) GetCoordinatorDiscoveryTimeoutMillis() int64 {
 	if x != nil {
-		return x.Retry
+		return x.CoordinatorDiscoveryTimeoutMillis
 	}
 	return 0
 }
 
-func (x *EgressConfig) GetBackoffPolicy() BackoffPolicy {
+func (x *Egress) GetPayloadFormat() PayloadFormat {
 	if x != nil {
-		return x.BackoffPolicy
+		return x.PayloadFormat
 	}
-	return BackoffPolicy_Exponential
+	return PayloadFormat_CLOUDEVENT
 }
 
-func (x *EgressConfig) GetBackoffDelay() uint64 {
+func (x *Egress) GetShutdownGraceMillis() uint64 {
 	if x != nil {
-		return x.BackoffDelay
+		return x.ShutdownGraceMillis
 	}
 	return 0
 }
 
-func (x *EgressConfig) GetTimeout() uint64 {
+func (x *Egress) GetDeliveryProxy() string {
 	if x != nil {
-		return x.Timeout
+		return x.DeliveryProxy
 	}
-	return 0
-}
-
-type Egress struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// consumer group name
-	ConsumerGroup string `protobuf:"bytes,1,opt,name=consumerGroup,proto3" json:"consumerGroup,omitempty"`
-	// destination is the sink where events are sent.
-	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	// destination CA Cert is the CA Cert used for HTTPS communication through destination
-	DestinationCACerts string `protobuf:"bytes,15,opt,name=destinationCACerts,proto3" json:"destinationCACerts,omitempty"`
-	// OIDC audience of the destination
-	DestinationAudience string `protobuf:"bytes,17,opt,name=destinationAudience,proto3" json:"destinationAudience,omitempty"`
-	// Types that are assignable to ReplyStrategy:
-	//
-	//	*Egress_ReplyUrl
-	//	*Egress_ReplyToOriginalTopic
-	//	*Egress_DiscardReply
-	ReplyStrategy isEgress_ReplyStrategy `protobuf_oneof:"replyStrategy"`
-	// replyUrl CA Cert is the CA Cert used for HTTPS communication through replyUrl
-	ReplyUrlCACerts string `protobuf:"bytes,16,opt,name=replyUrlCACerts,proto3" json:"replyUrlCACerts,omitempty"`
-	// OIDC audience of the replyUrl
-	ReplyUrlAudience string `protobuf:"bytes,18,opt,name=replyUrlAudience,proto3" json:"replyUrlAudience,omitempty"`
-	// A filter for performing exact match against Cloud Events attributes
-	Filter *Filter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
-	// Id of the egress
-	// It's the same as the Kubernetes resource uid
-	Uid string `protobuf:"bytes,6,opt,name=uid,proto3" json:"uid,omitempty"`
-	// Egress configuration.
-	// It overrides Resource's EgressConfig.
-	EgressConfig *EgressConfig `protobuf:"bytes,7,opt,name=egressConfig,proto3" json:"egressConfig,omitempty"`
-	// Delivery guarantee to use
-	// Empty defaults to unordered
-	DeliveryOrder DeliveryOrder `protobuf:"varint,8,opt,name=deliveryOrder,proto3,enum=DeliveryOrder" json:"deliveryOrder,omitempty"`
-	// Kafka record key type.
-	KeyType KeyType `protobuf:"varint,10,opt,name=keyType,proto3,enum=KeyType" json:"keyType,omitempty"`
-	// Resource reference.
-	//
-	// This reference is used to reference the associated resource for data plane
-	// activities such as:
-	// - tagging metrics
-	Reference *Reference `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"`
-	// CNCF CloudEvents SubscriptionsAPI compliant filters
-	DialectedFilter []*DialectedFilter `protobuf:"bytes,12,rep,name=dialectedFilter,proto3" json:"dialectedFilter,omitempty"`
-	// Number of virtual replicas.
-	VReplicas int32 `protobuf:"varint,13,opt,name=vReplicas,proto3" json:"vReplicas,omitempty"`
-	// Egress feature flags.
-	FeatureFlags *EgressFeatureFlags `protobuf:"bytes,14,opt,name=featureFlags,proto3" json:"featureFlags,omitempty"`
-	// Name of the service account to use for OIDC authentication.
-	OidcServiceAccountName string `protobuf:"bytes,19,opt,name=oidcServiceAccountName,proto3" json:"oidcServiceAccountName,omitempty"`
+	return ""
 }
 
-func (x *Egress) Reset() {
-	*x = Egress{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Egress) GetDisableAutoCommit() bool {
+	if x != nil {
+		return x.DisableAutoCommit
 	}
+	return false
 }
 
-func (x *Egress) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Egress) GetNullKeyPolicy() NullKeyPolicy {
+	if x != nil {
+		return x.NullKeyPolicy
+	}
+	return NullKeyPolicy_NULL_KEY_ROUND_ROBIN
 }
 
-func (*Egress) ProtoMessage() {}
-
-func (x *Egress) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Egress) GetRequestTimeoutMillis() uint64 {
+	if x != nil {
+		return x.RequestTimeoutMillis
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Egress.ProtoReflect.Descriptor instead.
-func (*Egress) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{13}
+func (x *Egress) GetIdGenerationStrategy() IdGenerationStrategy {
+	if x != nil {
+		return x.IdGenerationStrategy
+	}
+	return IdGenerationStrategy_ID_UUID
 }
 
-func (x *Egress) GetConsumerGroup() string {
+func (x *Egress) GetMaxConsecutiveDeserFailures() int32 {
 	if x != nil {
-		return x.ConsumerGroup
+		return x.MaxConsecutiveDeserFailures
 	}
-	return ""
+	return 0
 }
 
-func (x *Egress) GetDestination() string {
+func (x *Egress) GetKeepAliveMillis() uint64 {
 	if x != nil {
-		return x.Destination
+		return x.KeepAliveMillis
 	}
-	return ""
+	return 0
 }
 
-func (x *Egress) GetDestinationCACerts() string {
+func (x *Egress) GetSubscriberMaxIdleConns() int32 {
 	if x != nil {
-		return x.DestinationCACerts
+		return x.SubscriberMaxIdleConns
 	}
-	return ""
+	return 0
 }
 
-func (x *Egress) GetDestinationAudience() string {
+func (x *Egress) GetTopicDeletedPolicy() TopicDeletedPolicy {
 	if x != nil {
-		return x.DestinationAudience
+		return x.TopicDeletedPolicy
 	}
-	return ""
+	return TopicDeletedPolicy_TOPIC_DELETED_WAIT
 }
 
-func (m *Egress) GetReplyStrategy() isEgress_ReplyStrategy {
-	if m != nil {
-		return m.ReplyStrategy
+func (x *Egress) GetMetricsSampleRate() int32 {
+	if x != nil {
+		return x.MetricsSampleRate
 	}
-	return nil
+	return 0
 }
 
-func (x *Egress) GetReplyUrl() string {
-	if x, ok := x.GetReplyStrategy().(*Egress_ReplyUrl); ok {
-		return x.ReplyUrl
+func (x *Egress) GetOrderingFallback() OrderingFallback {
+	if x != nil {
+		return x.OrderingFallback
 	}
-	return ""
+	return OrderingFallback_ORDERING_FALLBACK_UNORDERED
 }
 
-func (x *Egress) GetReplyToOriginalTopic() *Empty {
-	if x, ok := x.GetReplyStrategy().(*Egress_ReplyToOriginalTopic); ok {
-		return x.ReplyToOriginalTopic
+func (x *Egress) GetTombstonePolicy() TombstonePolicy {
+	if x != nil {
+		return x.TombstonePolicy
 	}
-	return nil
+	return TombstonePolicy_TOMBSTONE_DELIVER
 }
 
-func (x *Egress) GetDiscardReply() *Empty {
-	if x, ok := x.GetReplyStrategy().(*Egress_DiscardReply); ok {
-		return x.DiscardReply
+func (x *Egress) GetCommitMode() CommitMode {
+	if x != nil {
+		return x.CommitMode
 	}
-	return nil
+	return CommitMode_COMMIT_ON_SUCCESS
 }
 
-func (x *Egress) GetReplyUrlCACerts() string {
+func (x *Egress) GetRebalanceTimeoutMillis() uint64 {
 	if x != nil {
-		return x.ReplyUrlCACerts
+		return x.RebalanceTimeoutMillis
 	}
-	return ""
+	return 0
 }
 
-func (x *Egress) GetReplyUrlAudience() string {
+func (x *Egress) GetReplyBootstrapServers() string {
 	if x != nil {
-		return x.ReplyUrlAudience
+		return x.ReplyBootstrapServers
 	}
 	return ""
 }
 
-func (x *Egress) GetFilter() *Filter {
+func (x *Egress) GetReplyAuth() *MultiSecretReference {
 	if x != nil {
-		return x.Filter
+		return x.ReplyAuth
 	}
 	return nil
 }
 
-func (x *Egress) GetUid() string {
+func (x *Egress) GetOrderingKeyHeader() string {
 	if x != nil {
-		return x.Uid
+		return x.OrderingKeyHeader
 	}
 	return ""
 }
 
-func (x *Egress) GetEgressConfig() *EgressConfig {
+func (x *Egress) GetTimeSource() *TimeSource {
 	if x != nil {
-		return x.EgressConfig
+		return x.TimeSource
 	}
 	return nil
 }
 
-func (x *Egress) GetDeliveryOrder() DeliveryOrder {
+func (x *Egress) GetDeliveryStallWindowMillis() uint64 {
 	if x != nil {
-		return x.DeliveryOrder
+		return x.DeliveryStallWindowMillis
 	}
-	return DeliveryOrder_UNORDERED
+	return 0
 }
 
-func (x *Egress) GetKeyType() KeyType {
+func (x *Egress) GetFlowControlWebhook() *FlowControlWebhook {
 	if x != nil {
-		return x.KeyType
+		return x.FlowControlWebhook
 	}
-	return KeyType_String
+	return nil
 }
 
-func (x *Egress) GetReference() *Reference {
+func (x *Egress) GetSigning() *DeliverySigning {
 	if x != nil {
-		return x.Reference
+		return x.Signing
 	}
 	return nil
 }
 
-func (x *Egress) GetDialectedFilter() []*DialectedFilter {
+func (x *Egress) GetDestinationClientCert() *Reference {
 	if x != nil {
-		return x.DialectedFilter
+		return x.DestinationClientCert
 	}
 	return nil
 }
 
-func (x *Egress) GetVReplicas() int32 {
+func (x *Egress) GetReplyMaxProducers() uint32 {
 	if x != nil {
-		return x.VReplicas
+		return x.ReplyMaxProducers
 	}
 	return 0
 }
 
-func (x *Egress) GetFeatureFlags() *EgressFeatureFlags {
-	if x != nil {
-		return x.FeatureFlags
-	}
-	return nil
-}
-
-func (x *Egress) GetOidcServiceAccountName() string {
+func (x *Egress) GetAuthorizationFailurePolicy() AuthorizationFailurePolicy {
 	if x != nil {
-		return x.OidcServiceAccountName
+		return x.AuthorizationFailurePolicy
 	}
-	return ""
+	return AuthorizationFailurePolicy_AUTHORIZATION_FAILURE_RETRY_WITH_BACKOFF
 }
 
 type isEgress_ReplyStrategy interface {
@@ -1417,7 +3264,7 @@ type EgressFeatureFlags struct {
 func (x *EgressFeatureFlags) Reset() {
 	*x = EgressFeatureFlags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[14]
+		mi := &file_contract_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1430,7 +3277,7 @@ func (x *EgressFeatureFlags) String() string {
 func (*EgressFeatureFlags) ProtoMessage() {}
 
 func (x *EgressFeatureFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[14]
+	mi := &file_contract_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,7 +3290,7 @@ func (x *EgressFeatureFlags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EgressFeatureFlags.ProtoReflect.Descriptor instead.
 func (*EgressFeatureFlags) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{14}
+	return file_contract_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EgressFeatureFlags) GetEnableRateLimiter() bool {
@@ -1488,7 +3335,7 @@ type Ingress struct {
 func (x *Ingress) Reset() {
 	*x = Ingress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[15]
+		mi := &file_contract_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1501,7 +3348,7 @@ func (x *Ingress) String() string {
 func (*Ingress) ProtoMessage() {}
 
 func (x *Ingress) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[15]
+	mi := &file_contract_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +3361,7 @@ func (x *Ingress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ingress.ProtoReflect.Descriptor instead.
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{15}
+	return file_contract_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Ingress) GetContentMode() ContentMode {
@@ -1575,7 +3422,7 @@ type Reference struct {
 func (x *Reference) Reset() {
 	*x = Reference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[16]
+		mi := &file_contract_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1588,7 +3435,7 @@ func (x *Reference) String() string {
 func (*Reference) ProtoMessage() {}
 
 func (x *Reference) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[16]
+	mi := &file_contract_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1601,7 +3448,7 @@ func (x *Reference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Reference.ProtoReflect.Descriptor instead.
 func (*Reference) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{16}
+	return file_contract_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Reference) GetUuid() string {
@@ -1660,7 +3507,7 @@ type SecretReference struct {
 func (x *SecretReference) Reset() {
 	*x = SecretReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[17]
+		mi := &file_contract_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1673,7 +3520,7 @@ func (x *SecretReference) String() string {
 func (*SecretReference) ProtoMessage() {}
 
 func (x *SecretReference) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[17]
+	mi := &file_contract_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,7 +3533,7 @@ func (x *SecretReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretReference.ProtoReflect.Descriptor instead.
 func (*SecretReference) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{17}
+	return file_contract_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SecretReference) GetReference() *Reference {
@@ -1717,7 +3564,7 @@ type KeyFieldReference struct {
 func (x *KeyFieldReference) Reset() {
 	*x = KeyFieldReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[18]
+		mi := &file_contract_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1730,7 +3577,7 @@ func (x *KeyFieldReference) String() string {
 func (*KeyFieldReference) ProtoMessage() {}
 
 func (x *KeyFieldReference) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[18]
+	mi := &file_contract_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +3590,7 @@ func (x *KeyFieldReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyFieldReference.ProtoReflect.Descriptor instead.
 func (*KeyFieldReference) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{18}
+	return file_contract_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *KeyFieldReference) GetSecretKey() string {
@@ -1774,7 +3621,7 @@ type MultiSecretReference struct {
 func (x *MultiSecretReference) Reset() {
 	*x = MultiSecretReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[19]
+		mi := &file_contract_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1787,7 +3634,7 @@ func (x *MultiSecretReference) String() string {
 func (*MultiSecretReference) ProtoMessage() {}
 
 func (x *MultiSecretReference) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[19]
+	mi := &file_contract_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,7 +3647,7 @@ func (x *MultiSecretReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiSecretReference.ProtoReflect.Descriptor instead.
 func (*MultiSecretReference) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{19}
+	return file_contract_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *MultiSecretReference) GetProtocol() Protocol {
@@ -1829,7 +3676,7 @@ type CloudEventOverrides struct {
 func (x *CloudEventOverrides) Reset() {
 	*x = CloudEventOverrides{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[20]
+		mi := &file_contract_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1842,7 +3689,7 @@ func (x *CloudEventOverrides) String() string {
 func (*CloudEventOverrides) ProtoMessage() {}
 
 func (x *CloudEventOverrides) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[20]
+	mi := &file_contract_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,7 +3702,7 @@ func (x *CloudEventOverrides) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloudEventOverrides.ProtoReflect.Descriptor instead.
 func (*CloudEventOverrides) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{20}
+	return file_contract_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CloudEventOverrides) GetExtensions() map[string]string {
@@ -1876,7 +3723,7 @@ type FeatureFlags struct {
 func (x *FeatureFlags) Reset() {
 	*x = FeatureFlags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[21]
+		mi := &file_contract_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1889,7 +3736,7 @@ func (x *FeatureFlags) String() string {
 func (*FeatureFlags) ProtoMessage() {}
 
 func (x *FeatureFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[21]
+	mi := &file_contract_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1902,7 +3749,7 @@ func (x *FeatureFlags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatureFlags.ProtoReflect.Descriptor instead.
 func (*FeatureFlags) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{21}
+	return file_contract_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *FeatureFlags) GetEnableEventTypeAutocreate() bool {
@@ -1912,6 +3759,76 @@ func (x *FeatureFlags) GetEnableEventTypeAutocreate() bool {
 	return false
 }
 
+// TLS settings for the connection to the Kafka cluster.
+type TLSConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Server name indication (SNI) to send on the TLS handshake.
+	// Empty means the bootstrap server host is used.
+	ServerName string `protobuf:"bytes,1,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	// Minimum TLS protocol version allowed on the handshake, "TLSv1.2" or "TLSv1.3".
+	// Empty means the dispatcher default is used.
+	MinVersion string `protobuf:"bytes,2,opt,name=minVersion,proto3" json:"minVersion,omitempty"`
+	// IANA names of the cipher suites allowed on the handshake.
+	// Empty means the dispatcher default cipher suites are used.
+	CipherSuites []string `protobuf:"bytes,3,rep,name=cipherSuites,proto3" json:"cipherSuites,omitempty"`
+}
+
+func (x *TLSConfig) Reset() {
+	*x = TLSConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contract_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSConfig) ProtoMessage() {}
+
+func (x *TLSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSConfig.ProtoReflect.Descriptor instead.
+func (*TLSConfig) Descriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TLSConfig) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *TLSConfig) GetMinVersion() string {
+	if x != nil {
+		return x.MinVersion
+	}
+	return ""
+}
+
+func (x *TLSConfig) GetCipherSuites() []string {
+	if x != nil {
+		return x.CipherSuites
+	}
+	return nil
+}
+
 type Resource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1950,12 +3867,14 @@ type Resource struct {
 	Reference *Reference `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"`
 	// Feature flags for the resource
 	FeatureFlags *FeatureFlags `protobuf:"bytes,12,opt,name=featureFlags,proto3" json:"featureFlags,omitempty"`
+	// Optional TLS settings for the connection to the Kafka cluster
+	TlsConfig *TLSConfig `protobuf:"bytes,13,opt,name=tlsConfig,proto3" json:"tlsConfig,omitempty"`
 }
 
 func (x *Resource) Reset() {
 	*x = Resource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[22]
+		mi := &file_contract_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1968,7 +3887,7 @@ func (x *Resource) String() string {
 func (*Resource) ProtoMessage() {}
 
 func (x *Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[22]
+	mi := &file_contract_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,7 +3900,7 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resource.ProtoReflect.Descriptor instead.
 func (*Resource) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{22}
+	return file_contract_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Resource) GetUid() string {
@@ -2075,6 +3994,13 @@ func (x *Resource) GetFeatureFlags() *FeatureFlags {
 	return nil
 }
 
+func (x *Resource) GetTlsConfig() *TLSConfig {
+	if x != nil {
+		return x.TlsConfig
+	}
+	return nil
+}
+
 type isResource_Auth interface {
 	isResource_Auth()
 }
@@ -2142,12 +4068,15 @@ type Contract struct {
 	Resources  []*Resource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
 	// PEM encoded CA trust bundles for HTTP client.
 	TrustBundles []string `protobuf:"bytes,3,rep,name=trustBundles,proto3" json:"trustBundles,omitempty"`
+	// The version of the contract schema the control plane emitted the contract with.
+	// 0 means the contract was emitted before the schema was versioned.
+	SchemaVersion uint32 `protobuf:"varint,4,opt,name=schemaVersion,proto3" json:"schemaVersion,omitempty"`
 }
 
 func (x *Contract) Reset() {
 	*x = Contract{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contract_proto_msgTypes[23]
+		mi := &file_contract_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2160,7 +4089,7 @@ func (x *Contract) String() string {
 func (*Contract) ProtoMessage() {}
 
 func (x *Contract) ProtoReflect() protoreflect.Message {
-	mi := &file_contract_proto_msgTypes[23]
+	mi := &file_contract_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2173,7 +4102,7 @@ func (x *Contract) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Contract.ProtoReflect.Descriptor instead.
 func (*Contract) Descriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{23}
+	return file_contract_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Contract) GetGeneration() uint64 {
@@ -2197,6 +4126,13 @@ func (x *Contract) GetTrustBundles() []string {
 	return nil
 }
 
+func (x *Contract) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
 var File_contract_proto protoreflect.FileDescriptor
 
 var file_contract_proto_rawDesc = []byte{
@@ -2274,7 +4210,7 @@ var file_contract_proto_rawDesc = []byte{
 	0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
 	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63,
 	0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x72, 0x73, 0x22, 0xd1, 0x06, 0x0a, 0x0c, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
 	0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
@@ -2293,197 +4229,558 @@ var file_contract_proto_rawDesc = []byte{
 	0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x63,
 	0x6b, 0x6f, 0x66, 0x66, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d,
 	0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x22, 0xd8, 0x06, 0x0a, 0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
-	0x79, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x3c, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
-	0x6f, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x14,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x2c, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x43, 0x41,
-	0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x55, 0x72, 0x6c, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c,
-	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x65,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34,
-	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x69,
-	0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x64,
-	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x37, 0x0a, 0x0c,
-	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x65, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a,
-	0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x86,
-	0x01, 0x0a, 0x12, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x1c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x19, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x1a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x15, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x64,
+	0x75, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x64, 0x65, 0x64, 0x75, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x7a, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x16, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x7a, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x7a, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x36,
+	0x0a, 0x16, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
+	0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43,
+	0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x30,
+	0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0x98, 0x01, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x22, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x22, 0x49, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x40, 0x0a,
+	0x05, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0xba, 0x01, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43,
+	0x65, 0x72, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xc4, 0x1f, 0x0a,
+	0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41,
+	0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12,
+	0x30, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x12,
+	0x3c, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2c, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x43, 0x41,
+	0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72,
+	0x6c, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0d,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08,
+	0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x28, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x64,
+	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x45, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x36,
+	0x0a, 0x16, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x6f, 0x69, 0x64, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41,
+	0x75, 0x74, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x75, 0x74, 0x6f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x70, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x69,
+	0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x6d, 0x69, 0x74,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x65, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x10,
+	0x6f, 0x6e, 0x4d, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x4d, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d,
+	0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x10, 0x6f, 0x6e, 0x4d, 0x61, 0x6c, 0x66, 0x6f,
+	0x72, 0x6d, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x16, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x3c, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x12, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
 	0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x09,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a,
-	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x7f, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x42,
-	0x0a, 0x12, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4b, 0x65, 0x79,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12,
-	0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x6f, 0x0a, 0x14, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-	0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0c, 0x46, 0x65, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x19, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0xa4, 0x04, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x0c,
-	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x23, 0x0a, 0x08, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x65, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0a, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x75,
-	0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x48, 0x00, 0x52, 0x0a, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2c,
-	0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00,
-	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0f,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
-	0x46, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-	0x65, 0x73, 0x52, 0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x31, 0x0a, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67,
-	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46,
-	0x6c, 0x61, 0x67, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x22, 0x77, 0x0a, 0x08,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x2a, 0x2c, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x65, 0x61,
-	0x72, 0x10, 0x01, 0x2a, 0x2b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01,
-	0x2a, 0x3d, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x65, 0x72, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x10, 0x02,
-	0x12, 0x0d, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x10, 0x03, 0x2a,
-	0x29, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a,
-	0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54,
-	0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x61, 0x0a, 0x0b, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x53,
-	0x4c, 0x5f, 0x4d, 0x45, 0x43, 0x48, 0x41, 0x4e, 0x49, 0x53, 0x4d, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x43, 0x41, 0x5f, 0x43, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x43, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12,
-	0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x05, 0x2a, 0x44, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x41,
-	0x49, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x53, 0x4c,
-	0x5f, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
-	0x53, 0x53, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x41, 0x53, 0x4c, 0x5f, 0x53, 0x53,
-	0x4c, 0x10, 0x03, 0x42, 0x5b, 0x0a, 0x2a, 0x64, 0x65, 0x76, 0x2e, 0x6b, 0x6e, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x61, 0x66, 0x6b,
-	0x61, 0x2e, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x42, 0x11, 0x44, 0x61, 0x74, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5a, 0x1a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2d, 0x70, 0x6c,
-	0x61, 0x6e, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x46, 0x72, 0x6f, 0x6d,
+	0x56, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x12, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x66, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x66, 0x12, 0x44, 0x0a, 0x13, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x13, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x14, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18,
+	0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x14, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12,
+	0x40, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x52, 0x11,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x18,
+	0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x63,
+	0x6b, 0x12, 0x32, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x46, 0x0a, 0x1e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x19, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x63, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x67, 0x53, 0x63, 0x61, 0x6c,
+	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6c,
+	0x61, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a,
+	0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4b,
+	0x65, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x0e, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x12, 0x2e, 0x0a, 0x12, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x4d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x12, 0x2e, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4d, 0x61,
+	0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x30, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x31, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x12,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x32, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x64, 0x72, 0x6f, 0x70, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x72, 0x6f,
+	0x70, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x6f, 0x6e, 0x6f, 0x72, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x34, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x6f, 0x6e,
+	0x6f, 0x72, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x05,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x21, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x36, 0x20, 0x01, 0x28, 0x03, 0x52, 0x21, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x34, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x30,
+	0x0a, 0x13, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x38, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x18, 0x39, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x3a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x6e, 0x75, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x75,
+	0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x6e, 0x75, 0x6c,
+	0x6c, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x49,
+	0x0a, 0x14, 0x69, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x49,
+	0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x52, 0x14, 0x69, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x40, 0x0a, 0x1b, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x65, 0x72,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1b,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65,
+	0x73, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6b,
+	0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x3f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x18,
+	0x40, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x43, 0x0a,
+	0x12, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x12,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x42, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x3d, 0x0a, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x10, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x3a, 0x0a, 0x0f, 0x74, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x44, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x54, 0x6f, 0x6d, 0x62, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x74, 0x6f, 0x6d, 0x62,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x45, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0b, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x72, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x18, 0x46, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x18, 0x48, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x12, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x4e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x4f, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x5b, 0x0a, 0x1a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x1a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x1c, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xb1, 0x01, 0x0a,
+	0x07, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x0d,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x22, 0xa3, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x12, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x12, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x6f,
+	0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x30, 0x0a,
+	0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0x9a, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0c,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x19,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41,
+	0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x19, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0x6f, 0x0a, 0x09, 0x54, 0x4c,
+	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x6e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65,
+	0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x22, 0xce, 0x04, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x22,
+	0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x08, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x08, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0a, 0x61, 0x62,
+	0x73, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x46, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x6c,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x42, 0x06, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x22, 0x9d, 0x01, 0x0a,
+	0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x2c, 0x0a, 0x0d,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0f, 0x0a,
+	0x0b, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x10, 0x01, 0x2a, 0x69, 0x0a, 0x10, 0x4f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x11,
+	0x0a, 0x0d, 0x4f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x44, 0x45,
+	0x41, 0x44, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x4f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43,
+	0x41, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x2b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x45, 0x44,
+	0x10, 0x01, 0x2a, 0x3d, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x10,
+	0x03, 0x2a, 0x36, 0x0a, 0x0e, 0x4d, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x41, 0x44,
+	0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x53, 0x0a, 0x11, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x12, 0x43, 0x4f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x49, 0x43, 0x4b, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x49, 0x43, 0x4b, 0x59, 0x10, 0x03, 0x2a, 0x28,
+	0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x01, 0x2a, 0x3a, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
+	0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49,
+	0x50, 0x54, 0x10, 0x01, 0x2a, 0x51, 0x0a, 0x0d, 0x4e, 0x75, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x4b, 0x45, 0x59,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x2a, 0x50, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x1b, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b,
+	0x5f, 0x55, 0x4e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43,
+	0x4b, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x3c, 0x0a, 0x0f, 0x54, 0x6f, 0x6d,
+	0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x15, 0x0a, 0x11,
+	0x54, 0x4f, 0x4d, 0x42, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4d, 0x42, 0x53, 0x54, 0x4f, 0x4e, 0x45,
+	0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01, 0x2a, 0x61, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a,
+	0x12, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x5f, 0x57,
+	0x41, 0x49, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x5f,
+	0x52, 0x45, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x90, 0x01, 0x0a, 0x1a, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x28, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x48, 0x4f,
+	0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x54, 0x48,
+	0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x42, 0x0a,
+	0x14, 0x49, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x44, 0x5f, 0x55, 0x55, 0x49, 0x44,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x44, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54, 0x10,
+	0x01, 0x2a, 0x34, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x32, 0x35, 0x36, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53,
+	0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x01, 0x2a, 0x5e, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49, 0x4d,
+	0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4b, 0x41, 0x46, 0x4b, 0x41, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x4e, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x23, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x53, 0x10, 0x01, 0x2a, 0x29, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x52, 0x55, 0x43,
+	0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x61, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x53, 0x4c, 0x5f, 0x4d,
+	0x45, 0x43, 0x48, 0x41, 0x4e, 0x49, 0x53, 0x4d, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41,
+	0x5f, 0x43, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43,
+	0x52, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08,
+	0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x05, 0x2a, 0x44, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x53, 0x4c, 0x5f, 0x50, 0x4c,
+	0x41, 0x49, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x53, 0x4c,
+	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x41, 0x53, 0x4c, 0x5f, 0x53, 0x53, 0x4c, 0x10, 0x03,
+	0x42, 0x5b, 0x0a, 0x2a, 0x64, 0x65, 0x76, 0x2e, 0x6b, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x2e, 0x62,
+	0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x11,
+	0x44, 0x61, 0x74, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5a, 0x1a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2d, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2498,97 +4795,146 @@ func file_contract_proto_rawDescGZIP() []byte {
 	return file_contract_proto_rawDescData
 }
 
-var file_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
+var file_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_contract_proto_goTypes = []interface{}{
-	(BackoffPolicy)(0),           // 0: BackoffPolicy
-	(DeliveryOrder)(0),           // 1: DeliveryOrder
-	(KeyType)(0),                 // 2: KeyType
-	(ContentMode)(0),             // 3: ContentMode
-	(SecretField)(0),             // 4: SecretField
-	(Protocol)(0),                // 5: Protocol
-	(*Empty)(nil),                // 6: Empty
-	(*Exact)(nil),                // 7: Exact
-	(*Prefix)(nil),               // 8: Prefix
-	(*Suffix)(nil),               // 9: Suffix
-	(*All)(nil),                  // 10: All
-	(*Any)(nil),                  // 11: Any
-	(*Not)(nil),                  // 12: Not
-	(*CESQL)(nil),                // 13: CESQL
-	(*DialectedFilter)(nil),      // 14: DialectedFilter
-	(*Filter)(nil),               // 15: Filter
-	(*TokenMatcher)(nil),         // 16: TokenMatcher
-	(*EventPolicy)(nil),          // 17: EventPolicy
-	(*EgressConfig)(nil),         // 18: EgressConfig
-	(*Egress)(nil),               // 19: Egress
-	(*EgressFeatureFlags)(nil),   // 20: EgressFeatureFlags
-	(*Ingress)(nil),              // 21: Ingress
-	(*Reference)(nil),            // 22: Reference
-	(*SecretReference)(nil),      // 23: SecretReference
-	(*KeyFieldReference)(nil),    // 24: KeyFieldReference
-	(*MultiSecretReference)(nil), // 25: MultiSecretReference
-	(*CloudEventOverrides)(nil),  // 26: CloudEventOverrides
-	(*FeatureFlags)(nil),         // 27: FeatureFlags
-	(*Resource)(nil),             // 28: Resource
-	(*Contract)(nil),             // 29: Contract
-	nil,                          // 30: Exact.AttributesEntry
-	nil,                          // 31: Prefix.AttributesEntry
-	nil,                          // 32: Suffix.AttributesEntry
-	nil,                          // 33: Filter.AttributesEntry
-	nil,                          // 34: CloudEventOverrides.ExtensionsEntry
+	(BackoffPolicy)(0),              // 0: BackoffPolicy
+	(OversizeHandling)(0),           // 1: OversizeHandling
+	(DeliveryOrder)(0),              // 2: DeliveryOrder
+	(KeyType)(0),                    // 3: KeyType
+	(MalformedReply)(0),             // 4: MalformedReply
+	(PartitionAssignor)(0),          // 5: PartitionAssignor
+	(PayloadFormat)(0),              // 6: PayloadFormat
+	(CommitMode)(0),                 // 7: CommitMode
+	(NullKeyPolicy)(0),              // 8: NullKeyPolicy
+	(OrderingFallback)(0),           // 9: OrderingFallback
+	(TombstonePolicy)(0),            // 10: TombstonePolicy
+	(TopicDeletedPolicy)(0),         // 11: TopicDeletedPolicy
+	(AuthorizationFailurePolicy)(0), // 12: AuthorizationFailurePolicy
+	(IdGenerationStrategy)(0),       // 13: IdGenerationStrategy
+	(SigningAlgorithm)(0),           // 14: SigningAlgorithm
+	(TimeSourceType)(0),             // 15: TimeSourceType
+	(AuditLevel)(0),                 // 16: AuditLevel
+	(ContentMode)(0),                // 17: ContentMode
+	(SecretField)(0),                // 18: SecretField
+	(Protocol)(0),                   // 19: Protocol
+	(*Empty)(nil),                   // 20: Empty
+	(*Exact)(nil),                   // 21: Exact
+	(*Prefix)(nil),                  // 22: Prefix
+	(*Suffix)(nil),                  // 23: Suffix
+	(*All)(nil),                     // 24: All
+	(*Any)(nil),                     // 25: Any
+	(*Not)(nil),                     // 26: Not
+	(*CESQL)(nil),                   // 27: CESQL
+	(*DialectedFilter)(nil),         // 28: DialectedFilter
+	(*Filter)(nil),                  // 29: Filter
+	(*TokenMatcher)(nil),            // 30: TokenMatcher
+	(*EventPolicy)(nil),             // 31: EventPolicy
+	(*EgressConfig)(nil),            // 32: EgressConfig
+	(*RebalanceCallback)(nil),       // 33: RebalanceCallback
+	(*FlowControlWebhook)(nil),      // 34: FlowControlWebhook
+	(*DeliverySigning)(nil),         // 35: DeliverySigning
+	(*TimeSource)(nil),              // 36: TimeSource
+	(*Audit)(nil),                   // 37: Audit
+	(*ShadowDelivery)(nil),          // 38: ShadowDelivery
+	(*Egress)(nil),                  // 39: Egress
+	(*EgressFeatureFlags)(nil),      // 40: EgressFeatureFlags
+	(*Ingress)(nil),                 // 41: Ingress
+	(*Reference)(nil),               // 42: Reference
+	(*SecretReference)(nil),         // 43: SecretReference
+	(*KeyFieldReference)(nil),       // 44: KeyFieldReference
+	(*MultiSecretReference)(nil),    // 45: MultiSecretReference
+	(*CloudEventOverrides)(nil),     // 46: CloudEventOverrides
+	(*FeatureFlags)(nil),            // 47: FeatureFlags
+	(*TLSConfig)(nil),               // 48: TLSConfig
+	(*Resource)(nil),                // 49: Resource
+	(*Contract)(nil),                // 50: Contract
+	nil,                             // 51: Exact.AttributesEntry
+	nil,                             // 52: Prefix.AttributesEntry
+	nil,                             // 53: Suffix.AttributesEntry
+	nil,                             // 54: Filter.AttributesEntry
+	nil,                             // 55: CloudEventOverrides.ExtensionsEntry
 }
 var file_contract_proto_depIdxs = []int32{
-	30, // 0: Exact.attributes:type_name -> Exact.AttributesEntry
-	31, // 1: Prefix.attributes:type_name -> Prefix.AttributesEntry
-	32, // 2: Suffix.attributes:type_name -> Suffix.AttributesEntry
-	14, // 3: All.filters:type_name -> DialectedFilter
-	14, // 4: Any.filters:type_name -> DialectedFilter
-	14, // 5: Not.filter:type_name -> DialectedFilter
-	7,  // 6: DialectedFilter.exact:type_name -> Exact
-	8,  // 7: DialectedFilter.prefix:type_name -> Prefix
-	9,  // 8: DialectedFilter.suffix:type_name -> Suffix
-	10, // 9: DialectedFilter.all:type_name -> All
-	11, // 10: DialectedFilter.any:type_name -> Any
-	12, // 11: DialectedFilter.not:type_name -> Not
-	13, // 12: DialectedFilter.cesql:type_name -> CESQL
-	33, // 13: Filter.attributes:type_name -> Filter.AttributesEntry
-	7,  // 14: TokenMatcher.exact:type_name -> Exact
-	8,  // 15: TokenMatcher.prefix:type_name -> Prefix
-	16, // 16: EventPolicy.tokenMatchers:type_name -> TokenMatcher
-	14, // 17: EventPolicy.filters:type_name -> DialectedFilter
+	51, // 0: Exact.attributes:type_name -> Exact.AttributesEntry
+	52, // 1: Prefix.attributes:type_name -> Prefix.AttributesEntry
+	53, // 2: Suffix.attributes:type_name -> Suffix.AttributesEntry
+	28, // 3: All.filters:type_name -> DialectedFilter
+	28, // 4: Any.filters:type_name -> DialectedFilter
+	28, // 5: Not.filter:type_name -> DialectedFilter
+	21, // 6: DialectedFilter.exact:type_name -> Exact
+	22, // 7: DialectedFilter.prefix:type_name -> Prefix
+	23, // 8: DialectedFilter.suffix:type_name -> Suffix
+	24, // 9: DialectedFilter.all:type_name -> All
+	25, // 10: DialectedFilter.any:type_name -> Any
+	26, // 11: DialectedFilter.not:type_name -> Not
+	27, // 12: DialectedFilter.cesql:type_name -> CESQL
+	54, // 13: Filter.attributes:type_name -> Filter.AttributesEntry
+	21, // 14: TokenMatcher.exact:type_name -> Exact
+	22, // 15: TokenMatcher.prefix:type_name -> Prefix
+	30, // 16: EventPolicy.tokenMatchers:type_name -> TokenMatcher
+	28, // 17: EventPolicy.filters:type_name -> DialectedFilter
 	0,  // 18: EgressConfig.backoffPolicy:type_name -> BackoffPolicy
-	6,  // 19: Egress.replyToOriginalTopic:type_name -> Empty
-	6,  // 20: Egress.discardReply:type_name -> Empty
-	15, // 21: Egress.filter:type_name -> Filter
-	18, // 22: Egress.egressConfig:type_name -> EgressConfig
-	1,  // 23: Egress.deliveryOrder:type_name -> DeliveryOrder
-	2,  // 24: Egress.keyType:type_name -> KeyType
-	22, // 25: Egress.reference:type_name -> Reference
-	14, // 26: Egress.dialectedFilter:type_name -> DialectedFilter
-	20, // 27: Egress.featureFlags:type_name -> EgressFeatureFlags
-	3,  // 28: Ingress.contentMode:type_name -> ContentMode
-	17, // 29: Ingress.eventPolicies:type_name -> EventPolicy
-	22, // 30: SecretReference.reference:type_name -> Reference
-	24, // 31: SecretReference.keyFieldReferences:type_name -> KeyFieldReference
-	4,  // 32: KeyFieldReference.field:type_name -> SecretField
-	5,  // 33: MultiSecretReference.protocol:type_name -> Protocol
-	23, // 34: MultiSecretReference.references:type_name -> SecretReference
-	34, // 35: CloudEventOverrides.extensions:type_name -> CloudEventOverrides.ExtensionsEntry
-	21, // 36: Resource.ingress:type_name -> Ingress
-	18, // 37: Resource.egressConfig:type_name -> EgressConfig
-	19, // 38: Resource.egresses:type_name -> Egress
-	6,  // 39: Resource.absentAuth:type_name -> Empty
-	22, // 40: Resource.authSecret:type_name -> Reference
-	25, // 41: Resource.multiAuthSecret:type_name -> MultiSecretReference
-	26, // 42: Resource.cloudEventOverrides:type_name -> CloudEventOverrides
-	22, // 43: Resource.reference:type_name -> Reference
-	27, // 44: Resource.featureFlags:type_name -> FeatureFlags
-	28, // 45: Contract.resources:type_name -> Resource
-	46, // [46:46] is the sub-list for method output_type
-	46, // [46:46] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+	17, // 19: EgressConfig.deadLetterContentMode:type_name -> ContentMode
+	1,  // 20: EgressConfig.oversizeHandling:type_name -> OversizeHandling
+	42, // 21: DeliverySigning.secret:type_name -> Reference
+	14, // 22: DeliverySigning.algorithm:type_name -> SigningAlgorithm
+	15, // 23: TimeSource.type:type_name -> TimeSourceType
+	16, // 24: Audit.level:type_name -> AuditLevel
+	20, // 25: Egress.replyToOriginalTopic:type_name -> Empty
+	20, // 26: Egress.discardReply:type_name -> Empty
+	29, // 27: Egress.filter:type_name -> Filter
+	32, // 28: Egress.egressConfig:type_name -> EgressConfig
+	2,  // 29: Egress.deliveryOrder:type_name -> DeliveryOrder
+	3,  // 30: Egress.keyType:type_name -> KeyType
+	42, // 31: Egress.reference:type_name -> Reference
+	28, // 32: Egress.dialectedFilter:type_name -> DialectedFilter
+	40, // 33: Egress.featureFlags:type_name -> EgressFeatureFlags
+	4,  // 34: Egress.onMalformedReply:type_name -> MalformedReply
+	17, // 35: Egress.contentMode:type_name -> ContentMode
+	42, // 36: Egress.originRef:type_name -> Reference
+	33, // 37: Egress.onPartitionsRevoked:type_name -> RebalanceCallback
+	33, // 38: Egress.onPartitionsAssigned:type_name -> RebalanceCallback
+	5,  // 39: Egress.partitionAssignor:type_name -> PartitionAssignor
+	38, // 40: Egress.shadowDelivery:type_name -> ShadowDelivery
+	37, // 41: Egress.audit:type_name -> Audit
+	6,  // 42: Egress.payloadFormat:type_name -> PayloadFormat
+	8,  // 43: Egress.nullKeyPolicy:type_name -> NullKeyPolicy
+	13, // 44: Egress.idGenerationStrategy:type_name -> IdGenerationStrategy
+	11, // 45: Egress.topicDeletedPolicy:type_name -> TopicDeletedPolicy
+	9,  // 46: Egress.orderingFallback:type_name -> OrderingFallback
+	10, // 47: Egress.tombstonePolicy:type_name -> TombstonePolicy
+	7,  // 48: Egress.commitMode:type_name -> CommitMode
+	45, // 49: Egress.replyAuth:type_name -> MultiSecretReference
+	36, // 50: Egress.timeSource:type_name -> TimeSource
+	34, // 51: Egress.flowControlWebhook:type_name -> FlowControlWebhook
+	35, // 52: Egress.signing:type_name -> DeliverySigning
+	42, // 53: Egress.destinationClientCert:type_name -> Reference
+	12, // 54: Egress.authorizationFailurePolicy:type_name -> AuthorizationFailurePolicy
+	17, // 55: Ingress.contentMode:type_name -> ContentMode
+	31, // 56: Ingress.eventPolicies:type_name -> EventPolicy
+	42, // 57: SecretReference.reference:type_name -> Reference
+	44, // 58: SecretReference.keyFieldReferences:type_name -> KeyFieldReference
+	18, // 59: KeyFieldReference.field:type_name -> SecretField
+	19, // 60: MultiSecretReference.protocol:type_name -> Protocol
+	43, // 61: MultiSecretReference.references:type_name -> SecretReference
+	55, // 62: CloudEventOverrides.extensions:type_name -> CloudEventOverrides.ExtensionsEntry
+	41, // 63: Resource.ingress:type_name -> Ingress
+	32, // 64: Resource.egressConfig:type_name -> EgressConfig
+	39, // 65: Resource.egresses:type_name -> Egress
+	20, // 66: Resource.absentAuth:type_name -> Empty
+	42, // 67: Resource.authSecret:type_name -> Reference
+	45, // 68: Resource.multiAuthSecret:type_name -> MultiSecretReference
+	46, // 69: Resource.cloudEventOverrides:type_name -> CloudEventOverrides
+	42, // 70: Resource.reference:type_name -> Reference
+	47, // 71: Resource.featureFlags:type_name -> FeatureFlags
+	48, // 72: Resource.tlsConfig:type_name -> TLSConfig
+	49, // 73: Contract.resources:type_name -> Resource
+	74, // [74:74] is the sub-list for method output_type
+	74, // [74:74] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_contract_proto_init() }
@@ -2754,7 +5100,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Egress); i {
+			switch v := v.(*RebalanceCallback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,7 +5112,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EgressFeatureFlags); i {
+			switch v := v.(*FlowControlWebhook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,7 +5124,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ingress); i {
+			switch v := v.(*DeliverySigning); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2790,7 +5136,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reference); i {
+			switch v := v.(*TimeSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2802,7 +5148,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretReference); i {
+			switch v := v.(*Audit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2814,7 +5160,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyFieldReference); i {
+			switch v := v.(*ShadowDelivery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2826,7 +5172,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiSecretReference); i {
+			switch v := v.(*Egress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2838,7 +5184,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloudEventOverrides); i {
+			switch v := v.(*EgressFeatureFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2850,7 +5196,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeatureFlags); i {
+			switch v := v.(*Ingress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2862,7 +5208,7 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resource); i {
+			switch v := v.(*Reference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2874,6 +5220,90 @@ func file_contract_proto_init() {
 			}
 		}
 		file_contract_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contract_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyFieldReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contract_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiSecretReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contract_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudEventOverrides); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contract_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contract_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contract_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contract_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Contract); i {
 			case 0:
 				return &v.state
@@ -2899,12 +5329,12 @@ func file_contract_proto_init() {
 		(*TokenMatcher_Exact)(nil),
 		(*TokenMatcher_Prefix)(nil),
 	}
-	file_contract_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_contract_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*Egress_ReplyUrl)(nil),
 		(*Egress_ReplyToOriginalTopic)(nil),
 		(*Egress_DiscardReply)(nil),
 	}
-	file_contract_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_contract_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*Resource_AbsentAuth)(nil),
 		(*Resource_AuthSecret)(nil),
 		(*Resource_MultiAuthSecret)(nil),
@@ -2914,8 +5344,8 @@ func file_contract_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_contract_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   29,
+			NumEnums:      20,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,10 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 )
 
+// SchemaVersion is the version of the contract schema emitted by the control plane, it's incremented
+// when the data plane needs to support a schema change to process the contracts correctly.
+const SchemaVersion uint32 = 1
+
 // IncrementGeneration increments Generation.
 func (x *Contract) IncrementGeneration() {
 	x.Generation++
@@ -21,8 +21,10 @@ import (
 	"crypto/x509"
 	"fmt"
 	"math"
+	"math/bits"
 	"sort"
 	"strings"
+	"time"
 
 	eventingv1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
 
@@ -260,6 +262,50 @@ func DurationMillisFromISO8601String(durationStr *string, defaultDurationMillis
 	return uint64(math.Abs(float64(ms.Milliseconds()))), nil
 }
 
+// MaxRetryDurationMillis returns the maximum total time in milliseconds the dispatcher backs off
+// across all the retries of an event, saturating at math.MaxUint64.
+func MaxRetryDurationMillis(egressConfig *contract.EgressConfig) uint64 {
+	if egressConfig == nil || egressConfig.Retry == 0 {
+		return 0
+	}
+	n := uint64(egressConfig.Retry)
+	var factor uint64
+	switch egressConfig.BackoffPolicy {
+	case contract.BackoffPolicy_Linear:
+		// The i-th retry is delayed by delay * i, so the total is delay * n(n+1)/2.
+		hi, lo := bits.Mul64(n, n+1)
+		if hi != 0 {
+			return math.MaxUint64
+		}
+		factor = lo / 2
+	default:
+		// The i-th retry is delayed by delay * 2^i, so the total is delay * (2^(n+1) - 2).
+		if n >= 63 {
+			return math.MaxUint64
+		}
+		factor = 1<<(n+1) - 2
+	}
+	hi, total := bits.Mul64(egressConfig.BackoffDelay, factor)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	return total
+}
+
+// ValidateRetryDuration returns an error when the maximum total backoff across the retries of
+// the given egress config exceeds maxRetryDuration.
+func ValidateRetryDuration(egressConfig *contract.EgressConfig, maxRetryDuration time.Duration) error {
+	total := MaxRetryDurationMillis(egressConfig)
+	if total <= uint64(maxRetryDuration.Milliseconds()) {
+		return nil
+	}
+	totalStr := fmt.Sprintf("%dms", total)
+	if total <= uint64(math.MaxInt64/time.Millisecond) {
+		totalStr = (time.Duration(total) * time.Millisecond).String()
+	}
+	return fmt.Errorf("invalid delivery: the maximum total retry duration %s of %d retries exceeds %s", totalStr, egressConfig.Retry, maxRetryDuration)
+}
+
 // Increment contract.Contract.Generation.
 func IncrementContractGeneration(ct *contract.Contract) {
 	ct.Generation = (ct.Generation + 1) % (math.MaxUint64 - 1)
@@ -191,6 +191,99 @@ func TestDurationMillisFromISO8601String(t *testing.T) {
 	}
 }
 
+func TestMaxRetryDurationMillis(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		egressConfig *contract.EgressConfig
+		expected     uint64
+	}{
+		{
+			name:     "nil",
+			expected: 0,
+		},
+		{
+			name:         "no retry",
+			egressConfig: &contract.EgressConfig{BackoffDelay: 1000},
+			expected:     0,
+		},
+		{
+			name:         "exponential",
+			egressConfig: &contract.EgressConfig{Retry: 3, BackoffPolicy: contract.BackoffPolicy_Exponential, BackoffDelay: 1000},
+			expected:     14000,
+		},
+		{
+			name:         "linear",
+			egressConfig: &contract.EgressConfig{Retry: 3, BackoffPolicy: contract.BackoffPolicy_Linear, BackoffDelay: 1000},
+			expected:     6000,
+		},
+		{
+			name:         "exponential overflow",
+			egressConfig: &contract.EgressConfig{Retry: 100, BackoffPolicy: contract.BackoffPolicy_Exponential, BackoffDelay: 1000},
+			expected:     math.MaxUint64,
+		},
+		{
+			name:         "linear overflow",
+			egressConfig: &contract.EgressConfig{Retry: math.MaxUint32, BackoffPolicy: contract.BackoffPolicy_Linear, BackoffDelay: math.MaxUint64 / 2},
+			expected:     math.MaxUint64,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaxRetryDurationMillis(tt.egressConfig); got != tt.expected {
+				t.Errorf("Got %d expected %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateRetryDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		egressConfig     *contract.EgressConfig
+		maxRetryDuration time.Duration
+		wantErr          string
+	}{
+		{
+			name:             "nil",
+			maxRetryDuration: time.Hour,
+		},
+		{
+			name:             "within ceiling",
+			egressConfig:     &contract.EgressConfig{Retry: 3, BackoffDelay: 1000},
+			maxRetryDuration: 14 * time.Second,
+		},
+		{
+			name:             "exceeds ceiling",
+			egressConfig:     &contract.EgressConfig{Retry: 10, BackoffDelay: 1000},
+			maxRetryDuration: 30 * time.Minute,
+			wantErr:          "invalid delivery: the maximum total retry duration 34m6s of 10 retries exceeds 30m0s",
+		},
+		{
+			name:             "overflow",
+			egressConfig:     &contract.EgressConfig{Retry: 100, BackoffDelay: 1000},
+			maxRetryDuration: time.Hour,
+			wantErr:          "invalid delivery: the maximum total retry duration 18446744073709551615ms of 100 retries exceeds 1h0m0s",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRetryDuration(tt.egressConfig, tt.maxRetryDuration)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Got unexpected error %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("Got error %v expected %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestIncrementContractGeneration(t *testing.T) {
 	t.Parallel()
 
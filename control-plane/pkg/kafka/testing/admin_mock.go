@@ -58,6 +58,14 @@ type MockKafkaClusterAdmin struct {
 
 	ErrorOnDeleteConsumerGroup error
 
+	// CreatePartitions
+	ExpectedPartitionsCount int32
+	ErrorOnCreatePartitions error
+
+	// DescribeConfig
+	ExpectedConfigEntriesOnDescribeConfig map[string][]sarama.ConfigEntry
+	ExpectedErrorOnDescribeConfig         error
+
 	OnClose func()
 
 	T *testing.T
@@ -195,7 +203,19 @@ func (m *MockKafkaClusterAdmin) CreatePartitions(topic string, count int32, assi
 		return brokenPipeError{}
 	}
 
-	panic("implement me")
+	if m.ExpectedPartitionsCount == 0 {
+		m.T.Errorf("unexpected partitions creation for topic %s", topic)
+	}
+
+	if topic != m.ExpectedTopicName {
+		m.T.Errorf("expected topic %s got %s", m.ExpectedTopicName, topic)
+	}
+
+	if count != m.ExpectedPartitionsCount {
+		m.T.Errorf("expected partitions count %d got %d", m.ExpectedPartitionsCount, count)
+	}
+
+	return m.ErrorOnCreatePartitions
 }
 
 func (m *MockKafkaClusterAdmin) AlterPartitionReassignments(topic string, assignment [][]int32) error {
@@ -224,7 +244,8 @@ func (m *MockKafkaClusterAdmin) DescribeConfig(resource sarama.ConfigResource) (
 	if m.ErrorBrokenPipe {
 		return nil, brokenPipeError{}
 	}
-	panic("implement me")
+
+	return m.ExpectedConfigEntriesOnDescribeConfig[resource.Name], m.ExpectedErrorOnDescribeConfig
 }
 
 func (m *MockKafkaClusterAdmin) AlterConfig(resourceType sarama.ConfigResourceType, name string, entries map[string]*string, validateOnly bool) error {
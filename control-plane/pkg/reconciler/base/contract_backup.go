@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ContractBackup stores a copy of the serialized contracts written to the data plane config maps,
+// for example to an object store outside the cluster.
+type ContractBackup interface {
+	// Store stores the serialized contract under the given key.
+	Store(ctx context.Context, key string, data []byte) error
+}
+
+// NewContractBackup returns the ContractBackup storing the contracts in the given directory,
+// or a NoopContractBackup when the directory isn't configured.
+func NewContractBackup(directory string) ContractBackup {
+	if directory == "" {
+		return NoopContractBackup{}
+	}
+	return FileContractBackup{Directory: directory}
+}
+
+// NoopContractBackup is the default ContractBackup, it doesn't store the contracts.
+type NoopContractBackup struct{}
+
+func (NoopContractBackup) Store(context.Context, string, []byte) error {
+	return nil
+}
+
+// FileContractBackup is a ContractBackup storing the contracts as files in a directory,
+// for example a volume backed by an object store bucket.
+type FileContractBackup struct {
+	Directory string
+}
+
+func (b FileContractBackup) Store(_ context.Context, key string, data []byte) error {
+	path := filepath.Join(b.Directory, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create contract backup directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write contract backup %s: %w", path, err)
+	}
+	return nil
+}
+
+// InMemoryContractBackup is a ContractBackup keeping the contracts in memory.
+type InMemoryContractBackup struct {
+	mu       sync.Mutex
+	contents map[string][]byte
+}
+
+func (b *InMemoryContractBackup) Store(_ context.Context, key string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.contents == nil {
+		b.contents = make(map[string][]byte)
+	}
+	b.contents[key] = data
+	return nil
+}
+
+// Get returns the contract stored under the given key.
+func (b *InMemoryContractBackup) Get(key string) ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	data, ok := b.contents[key]
+	return data, ok
+}
+
+// ContractBackupKey returns the key of the contract with the given generation written to the given config map.
+func ContractBackupKey(configMap *corev1.ConfigMap, generation uint64) string {
+	return fmt.Sprintf("%s/%s/%d", configMap.Namespace, configMap.Name, generation)
+}
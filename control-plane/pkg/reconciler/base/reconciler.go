@@ -98,6 +98,9 @@ type Reconciler struct {
 	ReceiverLabel   string
 
 	DataPlaneConfigMapTransformer ConfigMapOption
+
+	// ContractBackup stores a copy of the updated contracts, see NewContractBackup.
+	ContractBackup ContractBackup
 }
 
 func (r *Reconciler) IsReceiverRunning() bool {
@@ -132,21 +135,25 @@ type ConfigMapOption func(cm *corev1.ConfigMap)
 
 func NoopConfigmapOption(cm *corev1.ConfigMap) {}
 
-func (r *Reconciler) GetOrCreateDataPlaneConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+// GetOrCreateDataPlaneConfigMap returns the data plane ConfigMap, creating it when it doesn't exist,
+// and whether it has been created.
+func (r *Reconciler) GetOrCreateDataPlaneConfigMap(ctx context.Context) (*corev1.ConfigMap, bool, error) {
 
 	cm, err := r.KubeClient.CoreV1().
 		ConfigMaps(r.DataPlaneConfigMapNamespace).
 		Get(ctx, r.ContractConfigMapName, metav1.GetOptions{})
 
+	created := false
 	if apierrors.IsNotFound(err) {
 		cm, err = r.createDataPlaneConfigMap(ctx)
+		created = err == nil
 	}
 
 	if r.DataPlaneConfigMapTransformer != nil {
 		r.DataPlaneConfigMapTransformer(cm)
 	}
 
-	return cm, err
+	return cm, created, err
 }
 
 func (r *Reconciler) createDataPlaneConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
@@ -252,7 +259,13 @@ func (r *Reconciler) UpdateDataPlaneConfigMap(ctx context.Context, contract *con
 		return nil
 	}
 
-	// Resource changed, increment contract generation.
+	// Resource changed, write the contract.
+	return r.ForceUpdateDataPlaneConfigMap(ctx, contract, configMap)
+}
+
+// ForceUpdateDataPlaneConfigMap writes the given contract to the given config map, incrementing its
+// generation, even when the contract is semantically equal to the one in the config map.
+func (r *Reconciler) ForceUpdateDataPlaneConfigMap(ctx context.Context, contract *contract.Contract, configMap *corev1.ConfigMap) error {
 	coreconfig.IncrementContractGeneration(contract)
 
 	var data []byte
@@ -281,9 +294,20 @@ func (r *Reconciler) UpdateDataPlaneConfigMap(ctx context.Context, contract *con
 		return err
 	}
 
+	r.backupContract(ctx, configMap, contract.Generation, data)
+
 	return nil
 }
 
+// backupContract stores a copy of the contract written to the given config map.
+// Failures are only logged since the config map is already updated, and the data plane doesn't depend on the backup.
+func (r *Reconciler) backupContract(ctx context.Context, configMap *corev1.ConfigMap, generation uint64, data []byte) {
+	key := ContractBackupKey(configMap, generation)
+	if err := r.ContractBackup.Store(ctx, key, data); err != nil {
+		logging.FromContext(ctx).Desugar().Error("Failed to back up contract", zap.String("key", key), zap.Error(err))
+	}
+}
+
 func (r *Reconciler) UpdateDispatcherPodsContractGenerationAnnotation(ctx context.Context, logger *zap.Logger, volumeGeneration uint64) error {
 	pods, errors := r.PodLister.Pods(r.DataPlaneNamespace).List(r.dispatcherSelector())
 	if errors != nil {
@@ -309,6 +333,11 @@ func (r *Reconciler) UpdateReceiverConfigFeaturesUpdatedAnnotation(ctx context.C
 }
 
 func (r *Reconciler) UpdatePodsAnnotation(ctx context.Context, logger *zap.Logger, component, annotationKey, annotationValue string, pods []*corev1.Pod) error {
+	return r.UpdatePodsAnnotations(ctx, logger, component, map[string]string{annotationKey: annotationValue}, pods)
+}
+
+// UpdatePodsAnnotations sets the given annotations on the pods with a single update per pod.
+func (r *Reconciler) UpdatePodsAnnotations(ctx context.Context, logger *zap.Logger, component string, podAnnotations map[string]string, pods []*corev1.Pod) error {
 
 	var errors error
 
@@ -317,7 +346,7 @@ func (r *Reconciler) UpdatePodsAnnotation(ctx context.Context, logger *zap.Logge
 		logger.Debug(
 			"Update "+component+" pod annotation",
 			zap.String("pod", fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)),
-			zap.String(annotationKey, annotationValue),
+			zap.Any("annotations", podAnnotations),
 		)
 
 		// do not update cache copy
@@ -325,19 +354,23 @@ func (r *Reconciler) UpdatePodsAnnotation(ctx context.Context, logger *zap.Logge
 
 		annotations := pod.GetAnnotations()
 		if annotations == nil {
-			annotations = make(map[string]string, 1)
+			annotations = make(map[string]string, len(podAnnotations))
 		}
 
-		// Check whether pod's annotation is the expected one.
-		if v, ok := annotations[annotationKey]; ok {
-			if v == annotationValue {
-				logger.Debug(component + " pod annotation already up to date")
-				// annotation is already correct.
-				continue
+		// Check whether pod's annotations are the expected ones.
+		upToDate := true
+		for k, v := range podAnnotations {
+			if current, ok := annotations[k]; !ok || current != v {
+				upToDate = false
+				annotations[k] = v
 			}
 		}
+		if upToDate {
+			logger.Debug(component + " pod annotation already up to date")
+			// annotations are already correct.
+			continue
+		}
 
-		annotations[annotationKey] = annotationValue
 		pod.SetAnnotations(annotations)
 
 		if _, err := r.KubeClient.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{}); err != nil {
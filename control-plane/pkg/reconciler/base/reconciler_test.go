@@ -18,6 +18,8 @@ package base_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -99,9 +101,15 @@ func TestGetOrCreateDataPlaneConfigMap(t *testing.T) {
 		KubeClient: kubeclient.Get(ctx),
 	}
 
-	cm, err := r.GetOrCreateDataPlaneConfigMap(ctx)
+	cm, created, err := r.GetOrCreateDataPlaneConfigMap(ctx)
 	require.Nil(t, err)
 	require.NotNil(t, cm)
+	require.True(t, created)
+
+	cm, created, err = r.GetOrCreateDataPlaneConfigMap(ctx)
+	require.Nil(t, err)
+	require.NotNil(t, cm)
+	require.False(t, created)
 }
 
 func TestGetDataPlaneConfigMapDataEmptyConfigMap(t *testing.T) {
@@ -172,6 +180,35 @@ func TestUpdateDataPlaneConfigMap(t *testing.T) {
 	r := &base.Reconciler{
 		KubeClient:              kubeclient.Get(ctx),
 		ContractConfigMapFormat: base.Json,
+		ContractBackup:          base.NoopContractBackup{},
+	}
+
+	ct := &contract.Contract{}
+	ct.Resources = append(ct.Resources, &contract.Resource{Uid: "123"})
+
+	err = r.UpdateDataPlaneConfigMap(ctx, ct, cm)
+	require.Nil(t, err)
+}
+
+func TestUpdateDataPlaneConfigMapContractBackup(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "kafka-api-dispatcher-0",
+		},
+		BinaryData: map[string][]byte{base.ConfigMapDataKey: []byte("")},
+	}
+
+	_, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+	require.Nil(t, err)
+
+	backup := &base.InMemoryContractBackup{}
+	r := &base.Reconciler{
+		KubeClient:              kubeclient.Get(ctx),
+		ContractConfigMapFormat: base.Json,
+		ContractBackup:          backup,
 	}
 
 	ct := &contract.Contract{}
@@ -179,6 +216,31 @@ func TestUpdateDataPlaneConfigMap(t *testing.T) {
 
 	err = r.UpdateDataPlaneConfigMap(ctx, ct, cm)
 	require.Nil(t, err)
+
+	data, ok := backup.Get("ns/kafka-api-dispatcher-0/1")
+	require.True(t, ok)
+	require.Equal(t, cm.BinaryData[base.ConfigMapDataKey], data)
+
+	// An unchanged contract isn't written again.
+	err = r.UpdateDataPlaneConfigMap(ctx, ct, cm)
+	require.Nil(t, err)
+	_, ok = backup.Get("ns/kafka-api-dispatcher-0/2")
+	require.False(t, ok)
+}
+
+func TestNewContractBackup(t *testing.T) {
+	require.Equal(t, base.NoopContractBackup{}, base.NewContractBackup(""))
+
+	dir := t.TempDir()
+	backup := base.NewContractBackup(dir)
+	require.Equal(t, base.FileContractBackup{Directory: dir}, backup)
+
+	err := backup.Store(context.Background(), "ns/kafka-api-dispatcher-0/1", []byte("contract"))
+	require.Nil(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "ns", "kafka-api-dispatcher-0", "1"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("contract"), data)
 }
 
 func TestGetDataPlaneConfigMapDataCorrupted(t *testing.T) {
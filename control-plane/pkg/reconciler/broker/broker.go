@@ -115,7 +115,7 @@ func (r *Reconciler) reconcileKind(ctx context.Context, broker *eventing.Broker)
 	// Get contract config map. Do this in advance, otherwise
 	// the dataplane pods that need volume mounts to the contract configmap
 	// will get stuck and will never be ready.
-	contractConfigMap, err := r.GetOrCreateDataPlaneConfigMap(ctx)
+	contractConfigMap, _, err := r.GetOrCreateDataPlaneConfigMap(ctx)
 	if err != nil {
 		return statusConditionManager.FailedToGetConfigMap(err)
 	}
@@ -471,7 +471,7 @@ func (r *Reconciler) finalizeKind(ctx context.Context, broker *eventing.Broker)
 
 func (r *Reconciler) deleteResourceFromContractConfigMap(ctx context.Context, logger *zap.Logger, broker *eventing.Broker) error {
 	// Get contract config map.
-	contractConfigMap, err := r.GetOrCreateDataPlaneConfigMap(ctx)
+	contractConfigMap, _, err := r.GetOrCreateDataPlaneConfigMap(ctx)
 	// Handles https://github.com/knative-extensions/eventing-kafka-broker/issues/2893
 	// When the system namespace is deleted while we're running there is no point in
 	// trying to delete the resource from the ConfigMap since the entire ConfigMap
@@ -670,6 +670,9 @@ func (r *Reconciler) reconcilerBrokerResource(ctx context.Context, topic string,
 	if err != nil {
 		return nil, err
 	}
+	if err := coreconfig.ValidateRetryDuration(egressConfig, r.KafkaFeatureFlags.ControllerMaxRetryDuration()); err != nil {
+		return nil, err
+	}
 	resource.EgressConfig = egressConfig
 
 	return resource, nil
@@ -77,6 +77,7 @@ func NewController(ctx context.Context, watcher configmap.Watcher, env *config.E
 			DataPlaneConfigMapNamespace: env.DataPlaneConfigMapNamespace,
 			ContractConfigMapName:       env.ContractConfigMapName,
 			ContractConfigMapFormat:     env.ContractConfigMapFormat,
+			ContractBackup:              base.NewContractBackup(env.ContractBackupDirectory),
 			DataPlaneNamespace:          env.SystemNamespace,
 			DispatcherLabel:             base.BrokerDispatcherLabel,
 			ReceiverLabel:               base.BrokerReceiverLabel,
@@ -97,7 +98,7 @@ func NewController(ctx context.Context, watcher configmap.Watcher, env *config.E
 
 	logger := logging.FromContext(ctx)
 
-	_, err := reconciler.GetOrCreateDataPlaneConfigMap(ctx)
+	_, _, err := reconciler.GetOrCreateDataPlaneConfigMap(ctx)
 	if err != nil {
 		logger.Fatal("Failed to get or create data plane config map",
 			zap.String("configmap", env.DataPlaneConfigMapAsString()),
@@ -227,6 +227,7 @@ func (r *NamespacedReconciler) createReconcilerForBrokerInstance(broker *eventin
 			DataPlaneConfigConfigMapName: r.Reconciler.DataPlaneConfigConfigMapName,
 			ContractConfigMapName:        r.Reconciler.ContractConfigMapName,
 			ContractConfigMapFormat:      r.Reconciler.ContractConfigMapFormat,
+			ContractBackup:               r.Reconciler.ContractBackup,
 			DispatcherLabel:              r.DispatcherLabel,
 			ReceiverLabel:                r.ReceiverLabel,
 
@@ -502,6 +502,7 @@ func useTableNamespaced(t *testing.T, table TableTest, env *config.Env) {
 				DispatcherLabel:             base.BrokerDispatcherLabel,
 				ReceiverLabel:               base.BrokerReceiverLabel,
 				Tracker:                     &FakeTracker{},
+				ContractBackup:              base.NoopContractBackup{},
 			},
 			NamespaceLister:          listers.GetNamespaceLister(),
 			ConfigMapLister:          listers.GetConfigMapLister(),
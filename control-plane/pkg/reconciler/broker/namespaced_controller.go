@@ -101,6 +101,7 @@ func NewNamespacedController(ctx context.Context, watcher configmap.Watcher, env
 			DataPlaneConfigMapNamespace:  env.DataPlaneConfigMapNamespace,
 			ContractConfigMapName:        env.ContractConfigMapName,
 			ContractConfigMapFormat:      env.ContractConfigMapFormat,
+			ContractBackup:               base.NewContractBackup(env.ContractBackupDirectory),
 			DataPlaneNamespace:           env.SystemNamespace,
 			DispatcherLabel:              base.BrokerDispatcherLabel,
 			ReceiverLabel:                base.BrokerReceiverLabel,
@@ -208,7 +208,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, channel *messagingv1beta
 	statusConditionManager.TopicReady(topic)
 
 	// Get data plane config map.
-	contractConfigMap, err := r.GetOrCreateDataPlaneConfigMap(ctx)
+	contractConfigMap, _, err := r.GetOrCreateDataPlaneConfigMap(ctx)
 	if err != nil {
 		return statusConditionManager.FailedToResolveConfig(err)
 	}
@@ -397,7 +397,7 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, channel *messagingv1beta1
 	logger := kafkalogging.CreateFinalizeMethodLogger(ctx, channel)
 
 	// Get contract config map.
-	contractConfigMap, err := r.GetOrCreateDataPlaneConfigMap(ctx)
+	contractConfigMap, _, err := r.GetOrCreateDataPlaneConfigMap(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get contract config map %s: %w", r.DataPlaneConfigMapAsString(), err)
 	}
@@ -755,6 +755,9 @@ func (r *Reconciler) getChannelContractResource(ctx context.Context, topic strin
 	if err != nil {
 		return nil, err
 	}
+	if err := coreconfig.ValidateRetryDuration(egressConfig, r.KafkaFeatureFlags.ControllerMaxRetryDuration()); err != nil {
+		return nil, err
+	}
 	resource.EgressConfig = egressConfig
 
 	return resource, nil
@@ -2343,6 +2343,7 @@ func TestReconcileKind(t *testing.T) {
 				DataPlaneNamespace:          env.SystemNamespace,
 				DispatcherLabel:             base.ChannelDispatcherLabel,
 				ReceiverLabel:               base.ChannelReceiverLabel,
+				ContractBackup:              base.NoopContractBackup{},
 			},
 			Env: env,
 			GetKafkaClusterAdmin: func(_ context.Context, _ []string, _ *corev1.Secret) (sarama.ClusterAdmin, error) {
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/security"
+)
+
+// newAuthSecret returns the secret to use to connect to the Kafka cluster of the given Consumer,
+// or nil when the Consumer has no auth configured.
+func (r *Reconciler) newAuthSecret(ctx context.Context, c *kafkainternals.Consumer) (*corev1.Secret, error) {
+	if c.Spec.Auth == nil {
+		return nil, nil
+	}
+
+	if c.Spec.Auth.NetSpec != nil {
+		authContext, err := security.ResolveAuthContextFromNetSpec(r.SecretLister, c.GetNamespace(), *c.Spec.Auth.NetSpec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve auth context: %w", err)
+		}
+		return authContext.VirtualSecret, nil
+	}
+
+	if c.Spec.Auth.SecretSpec.HasSecret() {
+		secret, err := security.Secret(ctx, &SecretLocator{Consumer: c}, r.SecretProviderFunc())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get secret: %w", err)
+		}
+
+		authContext, err := security.ResolveAuthContextFromLegacySecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		return authContext.VirtualSecret, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+// cleanupPolicyConfig is the topic config holding the comma separated cleanup policies,
+// `compact`, `delete` or both.
+const cleanupPolicyConfig = "cleanup.policy"
+
+// reconcileTopicCompaction informs, when the check is enabled, that some of the Consumer topics are compacted,
+// on which the tombstones delete the records with the same key and replays don't return the deleted records.
+// The check is best-effort: failures are logged and don't fail the reconciliation, the last known
+// compaction of the topics is kept.
+func (r *Reconciler) reconcileTopicCompaction(ctx context.Context, c *kafkainternals.Consumer, topics *consumerTopics) {
+	if !r.KafkaFeatureFlags.IsControllerTopicCompactionCheckEnabled() {
+		c.ClearTopicsCompacted()
+		return
+	}
+
+	compacted, err := compactedTopics(ctx, c, topics)
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Debug("Failed to get topics cleanup policy", zap.Error(err))
+		return
+	}
+	if len(compacted) == 0 {
+		c.ClearTopicsCompacted()
+		return
+	}
+	c.MarkTopicsCompacted("topics %v are compacted, tombstones delete the records with the same key and replays only return the latest record of each key", compacted)
+}
+
+// compactedTopics returns the Consumer topics whose cleanup policy includes compaction.
+func compactedTopics(ctx context.Context, c *kafkainternals.Consumer, topics *consumerTopics) ([]string, error) {
+	kafkaClusterAdminClient, err := topics.clusterAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var compacted []string
+	for _, topic := range c.Spec.Topics {
+		entries, err := kafkaClusterAdminClient.DescribeConfig(sarama.ConfigResource{
+			Type:        sarama.TopicResource,
+			Name:        topic,
+			ConfigNames: []string{cleanupPolicyConfig},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe config of topic %s: %w", topic, err)
+		}
+		for _, entry := range entries {
+			if entry.Name == cleanupPolicyConfig && isCompactPolicy(entry.Value) {
+				compacted = append(compacted, topic)
+				break
+			}
+		}
+	}
+	return compacted, nil
+}
+
+func isCompactPolicy(policy string) bool {
+	for _, p := range strings.Split(policy, ",") {
+		if strings.TrimSpace(p) == "compact" {
+			return true
+		}
+	}
+	return false
+}
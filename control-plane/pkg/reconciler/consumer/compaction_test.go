@@ -0,0 +1,142 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
+)
+
+func TestReconcileTopicCompaction(t *testing.T) {
+	enabled, err := configapis.NewFeaturesConfigFromMap(&corev1.ConfigMap{
+		Data: map[string]string{"controller-topic-compaction-check": "enabled"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanupPolicy := func(policy string) []sarama.ConfigEntry {
+		return []sarama.ConfigEntry{{Name: cleanupPolicyConfig, Value: policy}}
+	}
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		compacted     bool
+		admin         *kafkatesting.MockKafkaClusterAdmin
+		wantCondition bool
+	}{
+		{
+			name:      "disabled",
+			compacted: true,
+		},
+		{
+			name:    "delete policy",
+			enabled: true,
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedConfigEntriesOnDescribeConfig: map[string][]sarama.ConfigEntry{
+					"t1": cleanupPolicy("delete"),
+					"t2": cleanupPolicy("delete"),
+				},
+			},
+		},
+		{
+			name:    "compact policy",
+			enabled: true,
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedConfigEntriesOnDescribeConfig: map[string][]sarama.ConfigEntry{
+					"t1": cleanupPolicy("delete"),
+					"t2": cleanupPolicy("compact"),
+				},
+			},
+			wantCondition: true,
+		},
+		{
+			name:    "compact and delete policy",
+			enabled: true,
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedConfigEntriesOnDescribeConfig: map[string][]sarama.ConfigEntry{
+					"t1": cleanupPolicy("compact,delete"),
+				},
+			},
+			wantCondition: true,
+		},
+		{
+			name:    "no longer compacted",
+			enabled: true,
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedConfigEntriesOnDescribeConfig: map[string][]sarama.ConfigEntry{},
+			},
+			compacted: true,
+		},
+		{
+			name:      "describe failure keeps the last known compaction",
+			enabled:   true,
+			compacted: true,
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedErrorOnDescribeConfig: errors.New("failed"),
+			},
+			wantCondition: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &kafkainternals.Consumer{
+				ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "ns"},
+				Spec: kafkainternals.ConsumerSpec{
+					Topics: []string{"t1", "t2"},
+					Configs: kafkainternals.ConsumerConfigs{
+						Configs: map[string]string{"bootstrap.servers": "kafka:9092"},
+					},
+				},
+			}
+			if tt.compacted {
+				c.MarkTopicsCompacted("compacted")
+			}
+
+			flags := configapis.DefaultFeaturesConfig()
+			if tt.enabled {
+				flags = enabled
+			}
+			r := &Reconciler{
+				KafkaFeatureFlags: flags,
+				GetKafkaClusterAdmin: func(_ context.Context, _ []string, _ *corev1.Secret) (sarama.ClusterAdmin, error) {
+					if tt.admin == nil {
+						t.Fatal("unexpected Kafka cluster admin creation")
+					}
+					tt.admin.T = t
+					return tt.admin, nil
+				},
+			}
+
+			r.reconcileTopicCompaction(context.Background(), c, r.newConsumerTopics(c))
+
+			if got := c.HasCompactedTopics(); got != tt.wantCondition {
+				t.Errorf("want TopicsCompacted condition %v, got %v", tt.wantCondition, c.Status.GetCondition(kafkainternals.ConsumerConditionTopicsCompacted))
+			}
+		})
+	}
+}
@@ -24,9 +24,12 @@ import (
 	"knative.dev/pkg/logging"
 
 	"github.com/kelseyhightower/envconfig"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"knative.dev/eventing/pkg/eventingtls"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	nodeinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/node"
 	podinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod"
 	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret"
 	"knative.dev/pkg/configmap"
@@ -36,15 +39,22 @@ import (
 
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
 	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	sinkinformer "knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/informers/eventing/v1alpha1/kafkasink"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/informers/internalskafkaeventing/v1alpha1/consumer"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/informers/internalskafkaeventing/v1alpha1/consumergroup"
 	creconciler "knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/reconciler/internalskafkaeventing/v1alpha1/consumer"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/clientpool"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
 	cgreconciler "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/consumergroup"
 )
 
 type ControllerConfig struct {
 	ContractConfigMapFormat string `required:"true" split_words:"true"`
+
+	// ContractBackupDirectory is the directory where a copy of the contracts is stored, the contracts
+	// aren't backed up when empty.
+	ContractBackupDirectory string `required:"false" split_words:"true"`
 }
 
 func NewController(ctx context.Context, watcher configmap.Watcher) *controller.Impl {
@@ -59,12 +69,23 @@ func NewController(ctx context.Context, watcher configmap.Watcher) *controller.I
 
 	r := &Reconciler{
 		SerDe:                      formatSerDeFromString(controllerConfig.ContractConfigMapFormat),
+		ContractBackup:             base.NewContractBackup(controllerConfig.ContractBackupDirectory),
 		ConsumerGroupLister:        consumergroup.Get(ctx).Lister(),
+		ConsumerLister:             consumerInformer.Lister(),
 		SecretLister:               secretinformer.Get(ctx).Lister(),
 		PodLister:                  podinformer.Get(ctx).Lister(),
+		NodeLister:                 nodeinformer.Get(ctx).Lister(),
 		KubeClient:                 kubeclient.Get(ctx),
 		KafkaFeatureFlags:          config.DefaultFeaturesConfig(),
 		TrustBundleConfigMapLister: trustBundleConfigMapInformer.Lister().ConfigMaps(system.Namespace()),
+		KafkaSinkLister:            sinkinformer.Get(ctx).Lister(),
+	}
+
+	clientPool := clientpool.Get(ctx)
+	if clientPool == nil {
+		r.GetKafkaClusterAdmin = clientpool.DisabledGetKafkaClusterAdminFunc
+	} else {
+		r.GetKafkaClusterAdmin = clientPool.GetClusterAdmin
 	}
 
 	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"))
@@ -80,12 +101,28 @@ func NewController(ctx context.Context, watcher configmap.Watcher) *controller.I
 	})
 	configStore.WatchConfigs(watcher)
 
+	// The resolver tracks the resolved subscribers, so that a Consumer is enqueued, and its egress
+	// re-resolved, when the address of its subscriber changes.
 	r.Resolver = resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
 
 	consumerInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
 	r.Tracker = impl.Tracker
-	secretinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(r.Tracker.OnChanged))
+	r.EnqueueConsumer = impl.Enqueue
+
+	secretinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(controller.EnsureTypeMeta(
+		r.Tracker.OnChanged,
+		corev1.SchemeGroupVersion.WithKind("Secret"),
+	)))
+
+	// Also enqueue the Consumers referencing a changed secret according to the secret index, so that
+	// auth secret rotations are picked up even before the secrets have been tracked by a reconcile.
+	if err := consumerInformer.Informer().AddIndexers(cache.Indexers{SecretIndex: secretIndexFunc}); err != nil {
+		panic(fmt.Errorf("failed to add consumer secret index: %w", err))
+	}
+	secretinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(
+		enqueueConsumersReferencingSecret(consumerInformer.Informer().GetIndexer(), impl.Enqueue),
+	))
 
 	globalResync := func(interface{}) {
 		impl.GlobalResync(consumerInformer.Informer())
@@ -19,19 +19,27 @@ package consumer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/eventing/pkg/eventingtls"
+	kubeclient "knative.dev/pkg/client/injection/kube/client/fake"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
 	reconcilertesting "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/tracker"
 
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	dynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
 
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	consumerinformer "knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/informers/internalskafkaeventing/v1alpha1/consumer"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
 
 	_ "knative.dev/pkg/client/injection/ducks/duck/v1/addressable/fake"
@@ -43,6 +51,7 @@ import (
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/factory/filtered/fake"
 
+	_ "knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/informers/eventing/v1alpha1/kafkasink/fake"
 	_ "knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/informers/internalskafkaeventing/v1alpha1/consumer/fake"
 	_ "knative.dev/eventing-kafka-broker/control-plane/pkg/client/injection/informers/internalskafkaeventing/v1alpha1/consumergroup/fake"
 )
@@ -71,6 +80,72 @@ func TestNewController(t *testing.T) {
 	}
 }
 
+func TestNewControllerSecretChanged(t *testing.T) {
+	ctx, cancel, _ := reconcilertesting.SetupFakeContextWithCancel(t, SetUpInformerSelector)
+	defer cancel()
+
+	dynamicScheme := runtime.NewScheme()
+	_ = fakekubeclientset.AddToScheme(dynamicScheme)
+
+	dynamicclient.With(ctx, dynamicScheme)
+
+	t.Setenv("CONSUMER_CONTRACT_CONFIG_MAP_FORMAT", "json")
+
+	impl := NewController(ctx, configmap.NewStaticWatcher(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "config-kafka-features",
+		},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "config-features",
+		},
+	}))
+
+	require.NoError(t, controller.StartInformers(ctx.Done(), secretinformer.Get(ctx).Informer()))
+
+	// A Consumer referencing a secret only through the tracker, like the secrets resolved
+	// during a reconcile.
+	tracked := &kafkainternals.Consumer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tracked"}}
+	require.NoError(t, impl.Tracker.TrackReference(tracker.Reference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  "ns",
+		Name:       "tracked-secret",
+	}, tracked))
+	// Tracking a reference enqueues the tracking Consumer.
+	key, _ := impl.WorkQueue().Get()
+	impl.WorkQueue().Forget(key)
+	impl.WorkQueue().Done(key)
+
+	// A Consumer referencing a secret in its spec, found through the secret index.
+	indexed := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "indexed"},
+		Spec: kafkainternals.ConsumerSpec{
+			SubscriberClientCertSecretRef: &corev1.LocalObjectReference{Name: "indexed-secret"},
+		},
+	}
+	require.NoError(t, consumerinformer.Get(ctx).Informer().GetIndexer().Add(indexed))
+
+	for _, name := range []string{"tracked-secret", "indexed-secret"} {
+		_, err := kubeclient.Get(ctx).CoreV1().Secrets("ns").Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return impl.WorkQueue().Len() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var got []string
+	for i := 0; i < 2; i++ {
+		key, _ := impl.WorkQueue().Get()
+		got = append(got, key.(types.NamespacedName).String())
+		impl.WorkQueue().Done(key)
+	}
+	require.ElementsMatch(t, []string{"ns/tracked", "ns/indexed"}, got)
+}
+
 func TestFormatSerDeFromString(t *testing.T) {
 	tt := []struct {
 		format string
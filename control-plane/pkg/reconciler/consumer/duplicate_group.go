@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
+)
+
+// reconcileDuplicateGroup applies the duplicate group policy to the Consumer when other Consumers in
+// the contract of the given pod consume under one of its consumer groups on the same Kafka cluster.
+// It returns an error when the Consumer must not be bound to the pod.
+func (r *Reconciler) reconcileDuplicateGroup(c *kafkainternals.Consumer, p *corev1.Pod, ct *contract.Contract) error {
+	policy := r.KafkaFeatureFlags.DuplicateGroupPolicy()
+	if policy == config.DuplicateGroupPolicyAllow {
+		c.ClearDuplicateGroup()
+		return nil
+	}
+
+	duplicates := duplicateGroupResources(c, ct)
+	if len(duplicates) == 0 {
+		c.ClearDuplicateGroup()
+		return nil
+	}
+
+	if policy == config.DuplicateGroupPolicyReject {
+		c.ClearDuplicateGroup()
+		return fmt.Errorf("consumer group is already used by Consumers %v bound to pod %s/%s",
+			duplicates, p.GetNamespace(), p.GetName())
+	}
+	c.MarkDuplicateGroup("Consumer group is also used by Consumers %v bound to pod %s/%s",
+		duplicates, p.GetNamespace(), p.GetName())
+	return nil
+}
+
+// duplicateGroupResources returns the sorted UIDs of the other resources in the contract with an egress
+// consuming under one of the Consumer groups on the same Kafka cluster.
+func duplicateGroupResources(c *kafkainternals.Consumer, ct *contract.Contract) []string {
+	groups := sets.New(c.Spec.Configs.Configs["group.id"])
+	if c.Spec.Configs.AdditionalGroupID != nil {
+		groups.Insert(*c.Spec.Configs.AdditionalGroupID)
+	}
+
+	duplicates := sets.New[string]()
+	for _, resource := range ct.Resources {
+		if resource.Uid == string(c.GetUID()) ||
+			resource.BootstrapServers != c.Spec.Configs.Configs["bootstrap.servers"] {
+			continue
+		}
+		for _, egress := range resource.Egresses {
+			if groups.Has(egress.ConsumerGroup) {
+				duplicates.Insert(resource.Uid)
+			}
+		}
+	}
+	return sets.List(duplicates)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pointer "knative.dev/pkg/ptr"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
+)
+
+func TestReconcileDuplicateGroup(t *testing.T) {
+	policy := func(p string) *configapis.KafkaFeatureFlags {
+		flags, err := configapis.NewFeaturesConfigFromMap(&corev1.ConfigMap{
+			Data: map[string]string{"controller-duplicate-group-policy": p},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return flags
+	}
+
+	shared := &contract.Contract{
+		Resources: []*contract.Resource{
+			{Uid: "c1", BootstrapServers: "kafka:9092", Egresses: []*contract.Egress{{ConsumerGroup: "g"}}},
+			{Uid: "c2", BootstrapServers: "kafka:9092", Egresses: []*contract.Egress{{ConsumerGroup: "g"}}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		flags      *configapis.KafkaFeatureFlags
+		ct         *contract.Contract
+		additional *string
+		wantErr    bool
+		wantCond   bool
+	}{
+		{
+			name:     "default policy warns",
+			flags:    configapis.DefaultFeaturesConfig(),
+			ct:       shared,
+			wantCond: true,
+		},
+		{
+			name:     "warn",
+			flags:    policy("warn"),
+			ct:       shared,
+			wantCond: true,
+		},
+		{
+			name:    "reject",
+			flags:   policy("reject"),
+			ct:      shared,
+			wantErr: true,
+		},
+		{
+			name:  "allow",
+			flags: policy("allow"),
+			ct:    shared,
+		},
+		{
+			name:  "reject without duplicates",
+			flags: policy("reject"),
+			ct: &contract.Contract{
+				Resources: []*contract.Resource{
+					{Uid: "c1", BootstrapServers: "kafka:9092", Egresses: []*contract.Egress{{ConsumerGroup: "g"}}},
+					{Uid: "c2", BootstrapServers: "kafka:9092", Egresses: []*contract.Egress{{ConsumerGroup: "other"}}},
+					{Uid: "c3", BootstrapServers: "other:9092", Egresses: []*contract.Egress{{ConsumerGroup: "g"}}},
+				},
+			},
+		},
+		{
+			name:  "reject additional group duplicate",
+			flags: policy("reject"),
+			ct: &contract.Contract{
+				Resources: []*contract.Resource{
+					{Uid: "c2", BootstrapServers: "kafka:9092", Egresses: []*contract.Egress{{ConsumerGroup: "g2"}}},
+				},
+			},
+			additional: pointer.String("g2"),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &kafkainternals.Consumer{
+				ObjectMeta: metav1.ObjectMeta{Name: "c1", Namespace: "ns", UID: "c1"},
+				Spec: kafkainternals.ConsumerSpec{
+					Configs: kafkainternals.ConsumerConfigs{
+						Configs:           map[string]string{"bootstrap.servers": "kafka:9092", "group.id": "g"},
+						AdditionalGroupID: tt.additional,
+					},
+				},
+			}
+			p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns"}}
+
+			r := &Reconciler{KafkaFeatureFlags: tt.flags}
+
+			err := r.reconcileDuplicateGroup(c, p, tt.ct)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("want error %v, got %v", tt.wantErr, err)
+			}
+			cond := c.Status.GetCondition(kafkainternals.ConsumerConditionDuplicateGroup)
+			if got := cond != nil && cond.IsTrue(); got != tt.wantCond {
+				t.Errorf("want condition %v, got %v", tt.wantCond, cond)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
+)
+
+// EffectiveConfigKey is the key of the Consumer contract resource in the effective config ConfigMap.
+const EffectiveConfigKey = "resource.json"
+
+// EffectiveConfigConfigMapName returns the name of the ConfigMap holding the effective config of the Consumer.
+func EffectiveConfigConfigMapName(c *kafkainternals.Consumer) string {
+	return kmeta.ChildName(c.GetName(), "-effective-config")
+}
+
+// reconcileEffectiveConfig writes, when enabled, the contract resource the dispatcher uses for the Consumer
+// to a ConfigMap owned by the Consumer, so that operators can inspect the merged config.
+// It's purely diagnostic: the written resource is a redacted copy and failures are logged without failing
+// the reconciliation.
+func (r *Reconciler) reconcileEffectiveConfig(ctx context.Context, c *kafkainternals.Consumer, resource *contract.Resource) {
+	if !r.KafkaFeatureFlags.IsControllerEffectiveConfigEnabled() {
+		return
+	}
+	if err := r.writeEffectiveConfig(ctx, c, resource); err != nil {
+		logging.FromContext(ctx).Desugar().Debug("Failed to write effective config", zap.Error(err))
+	}
+}
+
+func (r *Reconciler) writeEffectiveConfig(ctx context.Context, c *kafkainternals.Consumer, resource *contract.Resource) error {
+	redacted := proto.Clone(resource).(*contract.Resource)
+	redacted.BootstrapServers = redactBootstrapServers(redacted.BootstrapServers)
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(redacted)
+	if err != nil {
+		return fmt.Errorf("failed to marshal resource: %w", err)
+	}
+
+	desired := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            EffectiveConfigConfigMapName(c),
+			Namespace:       c.GetNamespace(),
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(c)},
+		},
+		Data: map[string]string{EffectiveConfigKey: string(data)},
+	}
+
+	configMaps := r.KubeClient.CoreV1().ConfigMaps(c.GetNamespace())
+	cm, err := configMaps.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if cm.Data[EffectiveConfigKey] == desired.Data[EffectiveConfigKey] {
+		return nil
+	}
+	cm = cm.DeepCopy()
+	cm.Data = desired.Data
+	_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	internalsapi "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+)
+
+// ConsumerHealth summarizes the health of a Consumer.
+type ConsumerHealth struct {
+	// Ready is whether the Consumer is ready.
+	Ready bool
+	// ObservedGeneration is the Consumer generation last processed by the controller.
+	ObservedGeneration int64
+	// Conditions are the Consumer conditions.
+	Conditions duckv1.Conditions
+	// LastReconcileTime is the last time a Consumer condition changed,
+	// it's zero when no condition was set.
+	LastReconcileTime time.Time
+	// Pod is the health of the dispatcher pod the Consumer is bound to,
+	// it's nil when the Consumer isn't placed yet.
+	Pod *PodHealth
+}
+
+// PodHealth summarizes the health of the dispatcher pod a Consumer is bound to.
+type PodHealth struct {
+	Namespace string
+	Name      string
+	// Found is whether the pod exists.
+	Found bool
+	// Running is whether the pod is running.
+	Running bool
+	// ContractGeneration is the generation of the contract mounted in the pod.
+	ContractGeneration string
+	// HasConsumer is whether the contract mounted in the pod includes the Consumer.
+	HasConsumer bool
+}
+
+// HealthSummary returns the health of the given Consumer from its status and
+// the annotations of the dispatcher pod it's bound to.
+func (r *Reconciler) HealthSummary(ctx context.Context, c *kafkainternals.Consumer) (*ConsumerHealth, error) {
+	h := &ConsumerHealth{
+		Ready:              c.IsReady(),
+		ObservedGeneration: c.Status.ObservedGeneration,
+		Conditions:         slices.Clone(c.Status.Conditions),
+	}
+	for _, cond := range c.Status.Conditions {
+		if t := cond.LastTransitionTime.Inner.Time; t.After(h.LastReconcileTime) {
+			h.LastReconcileTime = t
+		}
+	}
+
+	if c.Spec.PodBind == nil {
+		return h, nil
+	}
+	h.Pod = &PodHealth{Namespace: c.Spec.PodBind.PodNamespace, Name: c.Spec.PodBind.PodName}
+
+	p, err := r.PodLister.Pods(h.Pod.Namespace).Get(h.Pod.Name)
+	if apierrors.IsNotFound(err) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s/%s: %w", h.Pod.Namespace, h.Pod.Name, err)
+	}
+	h.Pod.Found = true
+	h.Pod.Running = p.Status.Phase == corev1.PodRunning
+	h.Pod.ContractGeneration = p.Annotations[base.VolumeGenerationAnnotationKey]
+	h.Pod.HasConsumer = podReportsConsumer(p, internalsapi.ExpectedConsumersAnnotationKey, c.UID)
+
+	return h, nil
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	fakepodinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/fake"
+	. "knative.dev/pkg/reconciler/testing"
+
+	internalsapi "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+)
+
+func TestHealthSummary(t *testing.T) {
+	ctx, _ := SetupFakeContext(t, SetUpInformerSelector)
+
+	pod := NewDispatcherPod("p1",
+		PodRunning(),
+		PodAnnotations(map[string]string{
+			base.VolumeGenerationAnnotationKey:          "2",
+			internalsapi.ExpectedConsumersAnnotationKey: "other," + ConsumerUUID,
+		}),
+	)
+	_ = fakepodinformer.Get(ctx).Informer().GetIndexer().Add(pod)
+
+	r := &Reconciler{PodLister: fakepodinformer.Get(ctx).Lister()}
+
+	t.Run("bound", func(t *testing.T) {
+		c := NewConsumer(1,
+			ConsumerUID(ConsumerUUID),
+			ConsumerSpec(NewConsumerSpec(
+				ConsumerPlacement(kafkainternals.PodBind{PodName: pod.Name, PodNamespace: pod.Namespace}),
+			)),
+		)
+		c.GetConditionSet().Manage(c.GetStatus()).InitializeConditions()
+		c.MarkReconcileContractSucceeded()
+		c.MarkBindSucceeded()
+
+		h, err := r.HealthSummary(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !h.Ready {
+			t.Error("want ready")
+		}
+		if h.LastReconcileTime.IsZero() {
+			t.Error("want last reconcile time")
+		}
+		want := PodHealth{
+			Namespace:          pod.Namespace,
+			Name:               pod.Name,
+			Found:              true,
+			Running:            true,
+			ContractGeneration: "2",
+			HasConsumer:        true,
+		}
+		if h.Pod == nil || *h.Pod != want {
+			t.Errorf("want pod health %+v, got %+v", want, h.Pod)
+		}
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		c := NewConsumer(1,
+			ConsumerUID(ConsumerUUID),
+			ConsumerSpec(NewConsumerSpec(
+				ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: pod.Namespace}),
+			)),
+		)
+		c.GetConditionSet().Manage(c.GetStatus()).InitializeConditions()
+		c.MarkReconcileContractSucceeded()
+		_ = c.MarkBindFailed(errors.New("pod not found"))
+
+		h, err := r.HealthSummary(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.Ready {
+			t.Error("want not ready")
+		}
+		bindFailed := false
+		for _, cond := range h.Conditions {
+			if cond.Type == kafkainternals.ConsumerConditionBind && cond.Status == corev1.ConditionFalse {
+				bindFailed = true
+			}
+		}
+		if !bindFailed {
+			t.Errorf("want bind condition false, got %v", h.Conditions)
+		}
+		if h.Pod == nil || h.Pod.Found || h.Pod.HasConsumer {
+			t.Errorf("want pod not found, got %+v", h.Pod)
+		}
+	})
+}
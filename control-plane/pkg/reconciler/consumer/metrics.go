@@ -0,0 +1,93 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/metrics"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+var (
+	timeToBindStat = stats.Int64("consumer_time_to_bind", "Time from the consumer creation to its first successful bind", stats.UnitMilliseconds)
+	// timeToBindDistribution defines the bucket boundaries for the histogram of time to bind metric.
+	// Bucket boundaries are 100ms, 1s, 10s, 30s, 60s, 5m and 10m.
+	timeToBindDistribution = view.Distribution(100, 1000, 10000, 30000, 60000, 300000, 600000)
+)
+
+var (
+	ConsumerGroupTagKey = tag.MustNewKey("consumer_group")
+)
+
+func init() {
+	views := []*view.View{
+		{
+			Description: "Time from the consumer creation to its first successful bind",
+			TagKeys:     []tag.Key{controller.NamespaceTagKey, ConsumerGroupTagKey},
+			Measure:     timeToBindStat,
+			Aggregation: timeToBindDistribution,
+		},
+	}
+	if err := view.Register(views...); err != nil {
+		panic(err)
+	}
+}
+
+// markBindSucceeded marks the Consumer as bound and, when it was never bound before, records
+// the time it took to bind it since its creation, so that the metric is emitted once per Consumer
+// and not when it's bound again, for example after a transient scheduling failure.
+// It returns whether the time to bind was recorded.
+func markBindSucceeded(ctx context.Context, c *kafkainternals.Consumer) bool {
+	wasBound := c.Status.GetCondition(kafkainternals.ConsumerConditionBind).IsTrue()
+	c.MarkBindSucceeded()
+	if c.Status.BoundAt != nil || c.CreationTimestamp.IsZero() {
+		return false
+	}
+	now := metav1.Now()
+	c.Status.BoundAt = &now
+	// The Consumers bound before the first bind time was reported have no first bind time,
+	// so their time to bind is unknown.
+	if wasBound {
+		return false
+	}
+	recordTimeToBind(ctx, c, now.Sub(c.CreationTimestamp.Time))
+	return true
+}
+
+func recordTimeToBind(ctx context.Context, c *kafkainternals.Consumer, d time.Duration) {
+	consumerGroup := ""
+	if cg := c.GetConsumerGroup(); cg != nil {
+		consumerGroup = cg.Name
+	}
+	ctx, err := tag.New(
+		ctx,
+		tag.Insert(controller.NamespaceTagKey, c.Namespace),
+		tag.Insert(ConsumerGroupTagKey, consumerGroup),
+	)
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, timeToBindStat.M(d.Milliseconds()))
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+func TestMarkBindSucceeded(t *testing.T) {
+	c := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Minute)),
+		},
+	}
+	c.GetConditionSet().Manage(c.GetStatus()).InitializeConditions()
+
+	if recorded := markBindSucceeded(context.Background(), c); !recorded {
+		t.Error("want time to bind recorded on the first bind")
+	}
+	if !c.Status.GetCondition(kafkainternals.ConsumerConditionBind).IsTrue() {
+		t.Error("want consumer bound")
+	}
+	if c.Status.BoundAt == nil {
+		t.Error("want first bind time set")
+	}
+	boundAt := c.Status.BoundAt.DeepCopy()
+
+	if recorded := markBindSucceeded(context.Background(), c); recorded {
+		t.Error("want time to bind not recorded on subsequent reconciles")
+	}
+
+	c.MarkBindFailed(errors.New("failed to schedule"))
+	if recorded := markBindSucceeded(context.Background(), c); recorded {
+		t.Error("want time to bind not recorded when bound again")
+	}
+	if !c.Status.BoundAt.Equal(boundAt) {
+		t.Errorf("want first bind time %v, got %v", boundAt, c.Status.BoundAt)
+	}
+}
+
+func TestMarkBindSucceededAlreadyBound(t *testing.T) {
+	c := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+	}
+	c.GetConditionSet().Manage(c.GetStatus()).InitializeConditions()
+	c.MarkBindSucceeded()
+
+	if recorded := markBindSucceeded(context.Background(), c); recorded {
+		t.Error("want time to bind not recorded for a Consumer bound before the first bind time was reported")
+	}
+	if c.Status.BoundAt == nil {
+		t.Error("want first bind time set")
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
+)
+
+const (
+	// OrderedDeliveryAnnotationKey is the annotation a subscriber uses to advertise whether it can
+	// handle ordered delivery.
+	OrderedDeliveryAnnotationKey = "kafka.eventing.knative.dev/ordered-delivery"
+	// OrderedDeliveryUnsupported is the OrderedDeliveryAnnotationKey value of subscribers that can't
+	// handle ordered delivery.
+	OrderedDeliveryUnsupported = "unsupported"
+)
+
+// reconcileSubscriberOrdering warns, when the check is enabled, that the Consumer requests ordered
+// delivery to a subscriber that can't handle it.
+// The check is best-effort: when the subscriber can't be inspected the Consumer isn't marked.
+func (r *Reconciler) reconcileSubscriberOrdering(ctx context.Context, c *kafkainternals.Consumer) {
+	if !r.KafkaFeatureFlags.IsControllerSubscriberOrderingCheckEnabled() ||
+		reconcileDeliveryOrder(c) != contract.DeliveryOrder_ORDERED ||
+		c.Spec.Subscriber.Ref == nil {
+		c.ClearSubscriberOrdering()
+		return
+	}
+
+	annotations, err := subscriberAnnotations(ctx, c)
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Debug("Failed to get subscriber annotations", zap.Error(err))
+		return
+	}
+	checkSubscriberOrdering(c, annotations)
+}
+
+// checkSubscriberOrdering marks the Consumer when the given subscriber annotations advertise that
+// the subscriber can't handle ordered delivery.
+func checkSubscriberOrdering(c *kafkainternals.Consumer, annotations map[string]string) {
+	if strings.EqualFold(annotations[OrderedDeliveryAnnotationKey], OrderedDeliveryUnsupported) {
+		c.MarkSubscriberOrderingUnsupported("Ordered delivery is requested but subscriber %s/%s advertises it's unsupported",
+			c.Spec.Subscriber.Ref.Kind, c.Spec.Subscriber.Ref.Name)
+		return
+	}
+	c.ClearSubscriberOrdering()
+}
+
+func subscriberAnnotations(ctx context.Context, c *kafkainternals.Consumer) (map[string]string, error) {
+	ref := c.Spec.Subscriber.Ref
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse subscriber API version %s: %w", ref.APIVersion, err)
+	}
+	gvr := apis.KindToResource(gv.WithKind(ref.Kind))
+
+	_, lister, err := addressable.Get(ctx).Get(ctx, gvr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lister for %s: %w", gvr, err)
+	}
+
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = c.GetNamespace()
+	}
+	obj, err := lister.ByNamespace(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriber %s/%s: %w", namespace, ref.Name, err)
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	return accessor.GetAnnotations(), nil
+}
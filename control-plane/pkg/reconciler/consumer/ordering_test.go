@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	kafkasource "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1"
+)
+
+func TestCheckSubscriberOrdering(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantWarning bool
+	}{
+		{
+			name: "no annotations",
+		},
+		{
+			name:        "ordered delivery supported",
+			annotations: map[string]string{OrderedDeliveryAnnotationKey: "supported"},
+		},
+		{
+			name:        "ordered delivery unsupported",
+			annotations: map[string]string{OrderedDeliveryAnnotationKey: OrderedDeliveryUnsupported},
+			wantWarning: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newOrderedConsumer()
+			checkSubscriberOrdering(c, tt.annotations)
+
+			cond := c.GetConditionSet().Manage(c.GetStatus()).GetCondition(kafkainternals.ConsumerConditionSubscriberOrdering)
+			if got := cond != nil && cond.IsFalse(); got != tt.wantWarning {
+				t.Errorf("want warning %v, got condition %v", tt.wantWarning, cond)
+			}
+			if !c.GetConditionSet().Manage(c.GetStatus()).IsHappy() {
+				t.Errorf("want the subscriber ordering condition not to affect readiness")
+			}
+		})
+	}
+}
+
+func TestReconcileSubscriberOrderingDisabled(t *testing.T) {
+	c := newOrderedConsumer()
+	c.MarkSubscriberOrderingUnsupported("unsupported")
+
+	r := &Reconciler{KafkaFeatureFlags: configapis.DefaultFeaturesConfig()}
+	r.reconcileSubscriberOrdering(context.Background(), c)
+
+	if cond := c.GetConditionSet().Manage(c.GetStatus()).GetCondition(kafkainternals.ConsumerConditionSubscriberOrdering); cond != nil {
+		t.Errorf("want no subscriber ordering condition, got %v", cond)
+	}
+}
+
+func newOrderedConsumer() *kafkainternals.Consumer {
+	c := &kafkainternals.Consumer{
+		Spec: kafkainternals.ConsumerSpec{
+			Delivery: &kafkainternals.DeliverySpec{Ordering: kafkasource.Ordered},
+			Subscriber: duckv1.Destination{
+				Ref: &duckv1.KReference{APIVersion: "v1", Kind: "Service", Name: "s"},
+			},
+		},
+	}
+	c.GetConditionSet().Manage(c.GetStatus()).InitializeConditions()
+	c.MarkReconcileContractSucceeded()
+	c.MarkBindSucceeded()
+	return c
+}
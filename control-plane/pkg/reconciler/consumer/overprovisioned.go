@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
+)
+
+// reconcileOverProvisionedReplicas warns, when the check is enabled, that the Consumer delivers the events
+// in order with more virtual replicas than partitions in its topics, in which case the extra virtual replicas
+// don't get any partition assigned and can't improve the throughput.
+// The check is best-effort: failures are logged and don't fail the reconciliation.
+func (r *Reconciler) reconcileOverProvisionedReplicas(ctx context.Context, c *kafkainternals.Consumer, topics *consumerTopics) {
+	if !r.KafkaFeatureFlags.IsControllerOverProvisionedReplicasCheckEnabled() ||
+		reconcileDeliveryOrder(c) != contract.DeliveryOrder_ORDERED {
+		c.ClearOverProvisionedReplicas()
+		return
+	}
+
+	partitions, err := topics.partitions(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Debug("Failed to get topics partitions", zap.Error(err))
+		return
+	}
+	checkOverProvisionedReplicas(c, partitions)
+}
+
+// checkOverProvisionedReplicas marks the Consumer when its virtual replicas exceed the given number of partitions.
+func checkOverProvisionedReplicas(c *kafkainternals.Consumer, partitions int32) {
+	if vReplicas := vReplicasOf(c); vReplicas > partitions {
+		c.MarkOverProvisionedReplicas("Consumer has %d virtual replicas with ordered delivery but topics %v have %d partitions",
+			vReplicas, c.Spec.Topics, partitions)
+		return
+	}
+	c.ClearOverProvisionedReplicas()
+}
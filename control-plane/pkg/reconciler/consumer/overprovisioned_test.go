@@ -0,0 +1,138 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	corev1 "k8s.io/api/core/v1"
+	pointer "knative.dev/pkg/ptr"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	sources "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1"
+	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
+)
+
+func TestReconcileOverProvisionedReplicas(t *testing.T) {
+	enabled, err := configapis.NewFeaturesConfigFromMap(&corev1.ConfigMap{
+		Data: map[string]string{"controller-overprovisioned-replicas-check": "enabled"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		flags      *configapis.KafkaFeatureFlags
+		ordering   sources.DeliveryOrdering
+		vReplicas  int32
+		partitions int
+		adminErr   error
+		given      bool
+		wantCond   bool
+	}{
+		{
+			name:       "feature disabled",
+			flags:      configapis.DefaultFeaturesConfig(),
+			ordering:   sources.Ordered,
+			vReplicas:  6,
+			partitions: 4,
+		},
+		{
+			name:       "unordered delivery",
+			flags:      enabled,
+			ordering:   sources.Unordered,
+			vReplicas:  6,
+			partitions: 4,
+		},
+		{
+			name:       "partitions above vreplicas",
+			flags:      enabled,
+			ordering:   sources.Ordered,
+			vReplicas:  2,
+			partitions: 4,
+		},
+		{
+			name:       "partitions equal to vreplicas",
+			flags:      enabled,
+			ordering:   sources.Ordered,
+			vReplicas:  4,
+			partitions: 4,
+		},
+		{
+			name:       "partitions below vreplicas",
+			flags:      enabled,
+			ordering:   sources.Ordered,
+			vReplicas:  6,
+			partitions: 4,
+			wantCond:   true,
+		},
+		{
+			name:      "admin error keeps the condition",
+			flags:     enabled,
+			ordering:  sources.Ordered,
+			vReplicas: 6,
+			adminErr:  errors.New("unavailable"),
+			given:     true,
+			wantCond:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &kafkainternals.Consumer{
+				Spec: kafkainternals.ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: kafkainternals.ConsumerConfigs{
+						Configs: map[string]string{"bootstrap.servers": "kafka:9092", "group.id": "g"},
+					},
+					Delivery:  &kafkainternals.DeliverySpec{Ordering: tt.ordering},
+					VReplicas: pointer.Int32(tt.vReplicas),
+				},
+			}
+			if tt.given {
+				c.MarkOverProvisionedReplicas("given")
+			}
+
+			r := &Reconciler{
+				KafkaFeatureFlags: tt.flags,
+				GetKafkaClusterAdmin: func(_ context.Context, _ []string, _ *corev1.Secret) (sarama.ClusterAdmin, error) {
+					return &kafkatesting.MockKafkaClusterAdmin{
+						T:                             t,
+						ExpectedTopics:                []string{"t1"},
+						ExpectedErrorOnDescribeTopics: tt.adminErr,
+						ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{{
+							Name:       "t1",
+							Partitions: make([]*sarama.PartitionMetadata, tt.partitions),
+						}},
+					}, nil
+				},
+			}
+
+			r.reconcileOverProvisionedReplicas(context.Background(), c, r.newConsumerTopics(c))
+
+			cond := c.Status.GetCondition(kafkainternals.ConsumerConditionOverProvisionedReplicas)
+			if got := cond != nil && cond.IsTrue(); got != tt.wantCond {
+				t.Errorf("want %v, got %v", tt.wantCond, got)
+			}
+		})
+	}
+}
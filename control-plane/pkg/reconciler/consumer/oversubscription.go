@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+// reconcileVReplicasOversubscription warns, when the check is enabled, that the bound Consumers of the
+// Consumer group have more virtual replicas in total than partitions in their topics, in which case
+// some virtual replicas don't get any partition assigned.
+// The check is best-effort: failures are logged and don't fail the reconciliation.
+func (r *Reconciler) reconcileVReplicasOversubscription(ctx context.Context, c *kafkainternals.Consumer, topics *consumerTopics) {
+	if !r.KafkaFeatureFlags.IsControllerVReplicasOversubscriptionCheckEnabled() {
+		c.ClearVReplicasOversubscribed()
+		return
+	}
+
+	vReplicas, err := r.groupVReplicas(c)
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Debug("Failed to list group Consumers", zap.Error(err))
+		return
+	}
+	partitions, err := topics.partitions(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Debug("Failed to get topics partitions", zap.Error(err))
+		return
+	}
+	checkVReplicasOversubscription(c, vReplicas, partitions)
+}
+
+// checkVReplicasOversubscription marks the Consumer when the given total number of virtual replicas of
+// the Consumer group exceeds the given number of partitions.
+func checkVReplicasOversubscription(c *kafkainternals.Consumer, vReplicas, partitions int32) {
+	if vReplicas > partitions {
+		c.MarkVReplicasOversubscribed("Consumer group %s has %d virtual replicas but topics %v have %d partitions",
+			c.Spec.Configs.Configs["group.id"], vReplicas, c.Spec.Topics, partitions)
+		return
+	}
+	c.ClearVReplicasOversubscribed()
+}
+
+// groupVReplicas returns the total number of virtual replicas of the bound Consumers, including the
+// given one, that belong to the same Consumer group on the same Kafka cluster.
+func (r *Reconciler) groupVReplicas(c *kafkainternals.Consumer) (int32, error) {
+	consumers, err := r.ConsumerLister.Consumers(c.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+
+	vReplicas := vReplicasOf(c)
+	for _, other := range consumers {
+		if other.GetUID() == c.GetUID() || !sameGroup(c, other) ||
+			other.Spec.PodBind == nil || other.GetDeletionTimestamp() != nil {
+			continue
+		}
+		vReplicas += vReplicasOf(other)
+	}
+	return vReplicas, nil
+}
+
+func sameGroup(a, b *kafkainternals.Consumer) bool {
+	return a.Spec.Configs.Configs["group.id"] == b.Spec.Configs.Configs["group.id"] &&
+		a.Spec.Configs.Configs["bootstrap.servers"] == b.Spec.Configs.Configs["bootstrap.servers"]
+}
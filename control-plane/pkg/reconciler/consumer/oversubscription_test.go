@@ -0,0 +1,133 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	pointer "knative.dev/pkg/ptr"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	kafkainternalslisters "knative.dev/eventing-kafka-broker/control-plane/pkg/client/listers/internalskafkaeventing/v1alpha1"
+	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
+)
+
+func TestReconcileVReplicasOversubscription(t *testing.T) {
+	enabled, err := configapis.NewFeaturesConfigFromMap(&corev1.ConfigMap{
+		Data: map[string]string{"controller-vreplicas-oversubscription-check": "enabled"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newConsumer := func(name, group string, vReplicas int32, bound bool) *kafkainternals.Consumer {
+		c := &kafkainternals.Consumer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(name)},
+			Spec: kafkainternals.ConsumerSpec{
+				Topics: []string{"t1"},
+				Configs: kafkainternals.ConsumerConfigs{
+					Configs: map[string]string{"bootstrap.servers": "kafka:9092", "group.id": group},
+				},
+				VReplicas: pointer.Int32(vReplicas),
+			},
+		}
+		if bound {
+			c.Spec.PodBind = &kafkainternals.PodBind{PodName: "p-" + name, PodNamespace: "ns"}
+		}
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		flags    *configapis.KafkaFeatureFlags
+		others   []*kafkainternals.Consumer
+		wantCond bool
+	}{
+		{
+			name:  "feature disabled",
+			flags: configapis.DefaultFeaturesConfig(),
+			others: []*kafkainternals.Consumer{
+				newConsumer("c2", "g", 4, true),
+			},
+		},
+		{
+			name:  "vreplicas within partitions",
+			flags: enabled,
+			others: []*kafkainternals.Consumer{
+				newConsumer("c2", "g", 2, true),
+			},
+		},
+		{
+			name:  "vreplicas exceed partitions",
+			flags: enabled,
+			others: []*kafkainternals.Consumer{
+				newConsumer("c2", "g", 3, true),
+			},
+			wantCond: true,
+		},
+		{
+			name:  "ignore unbound and other groups Consumers",
+			flags: enabled,
+			others: []*kafkainternals.Consumer{
+				newConsumer("c2", "g", 3, false),
+				newConsumer("c3", "other", 3, true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newConsumer("c1", "g", 2, true)
+
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, o := range append(tt.others, c) {
+				if err := indexer.Add(o); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			r := &Reconciler{
+				KafkaFeatureFlags: tt.flags,
+				ConsumerLister:    kafkainternalslisters.NewConsumerLister(indexer),
+				GetKafkaClusterAdmin: func(_ context.Context, _ []string, _ *corev1.Secret) (sarama.ClusterAdmin, error) {
+					return &kafkatesting.MockKafkaClusterAdmin{
+						T:              t,
+						ExpectedTopics: []string{"t1"},
+						ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{{
+							Name:       "t1",
+							Partitions: make([]*sarama.PartitionMetadata, 4),
+						}},
+					}, nil
+				},
+			}
+
+			r.reconcileVReplicasOversubscription(context.Background(), c, r.newConsumerTopics(c))
+
+			cond := c.Status.GetCondition(kafkainternals.ConsumerConditionVReplicasOversubscribed)
+			if got := cond != nil && cond.IsTrue(); got != tt.wantCond {
+				t.Errorf("want %v, got %v", tt.wantCond, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+// reconcileNamespaceQuota returns an error when the Consumer, along with the Consumers of its namespace
+// created before it, exceeds the namespace quota of Consumers or of egresses.
+// The Consumers created first are within the quota, so that creating a Consumer never makes an existing
+// one exceed the quota.
+func (r *Reconciler) reconcileNamespaceQuota(c *kafkainternals.Consumer) error {
+	consumerQuota := r.KafkaFeatureFlags.ControllerNamespaceConsumerQuota()
+	egressQuota := r.KafkaFeatureFlags.ControllerNamespaceEgressQuota()
+	if consumerQuota <= 0 && egressQuota <= 0 {
+		return nil
+	}
+
+	consumers, err := r.ConsumerLister.Consumers(c.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list Consumers in namespace %s: %w", c.GetNamespace(), err)
+	}
+
+	nConsumers, nEgresses := 1, egressesOf(c)
+	for _, other := range consumers {
+		if other.GetUID() == c.GetUID() || other.GetDeletionTimestamp() != nil || !createdBefore(other, c) {
+			continue
+		}
+		nConsumers++
+		nEgresses += egressesOf(other)
+	}
+
+	if consumerQuota > 0 && nConsumers > consumerQuota {
+		return fmt.Errorf("namespace %s exceeds the quota of %d Consumers", c.GetNamespace(), consumerQuota)
+	}
+	if egressQuota > 0 && nEgresses > egressQuota {
+		return fmt.Errorf("namespace %s exceeds the quota of %d egresses, Consumer %s has %d egresses",
+			c.GetNamespace(), egressQuota, c.GetName(), egressesOf(c))
+	}
+	return nil
+}
+
+// createdBefore returns whether a was created before b, Consumers created at the same time are ordered
+// by name.
+func createdBefore(a, b *kafkainternals.Consumer) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.GetName() < b.GetName()
+}
+
+// egressesOf returns the number of egresses of the contract resource of the Consumer, which has an
+// egress per topic route, one for the topics without a route, and a copy of them for the additional
+// consumer group.
+func egressesOf(c *kafkainternals.Consumer) int {
+	n := 1
+	if len(c.Spec.TopicRoutes) > 0 {
+		routed := sets.New[string]()
+		for _, route := range c.Spec.TopicRoutes {
+			routed.Insert(route.Topic)
+		}
+		n = len(c.Spec.TopicRoutes)
+		for _, topic := range c.Spec.Topics {
+			if !routed.Has(topic) {
+				n++
+				break
+			}
+		}
+	}
+	if c.Spec.Configs.AdditionalGroupID != nil {
+		n *= 2
+	}
+	return n
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	pointer "knative.dev/pkg/ptr"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	kafkainternalslisters "knative.dev/eventing-kafka-broker/control-plane/pkg/client/listers/internalskafkaeventing/v1alpha1"
+)
+
+func TestReconcileNamespaceQuota(t *testing.T) {
+	quota := func(consumers, egresses string) *configapis.KafkaFeatureFlags {
+		flags, err := configapis.NewFeaturesConfigFromMap(&corev1.ConfigMap{
+			Data: map[string]string{
+				"controller-namespace-consumer-quota": consumers,
+				"controller-namespace-egress-quota":   egresses,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return flags
+	}
+
+	now := time.Now()
+	newConsumer := func(name string, age time.Duration) *kafkainternals.Consumer {
+		return &kafkainternals.Consumer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "ns",
+				UID:               types.UID(name),
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: kafkainternals.ConsumerSpec{Topics: []string{"t1", "t2"}},
+		}
+	}
+	deleting := newConsumer("c0", time.Hour)
+	deleting.DeletionTimestamp = &metav1.Time{Time: now}
+	routed := newConsumer("c0", time.Hour)
+	routed.Spec.TopicRoutes = []kafkainternals.TopicRoute{{Topic: "t1"}}
+
+	tests := []struct {
+		name    string
+		flags   *configapis.KafkaFeatureFlags
+		others  []*kafkainternals.Consumer
+		wantErr bool
+	}{
+		{
+			name:   "unlimited",
+			flags:  configapis.DefaultFeaturesConfig(),
+			others: []*kafkainternals.Consumer{newConsumer("c0", time.Hour), newConsumer("c2", 2*time.Hour)},
+		},
+		{
+			name:   "within Consumer quota",
+			flags:  quota("2", "0"),
+			others: []*kafkainternals.Consumer{newConsumer("c0", time.Hour), newConsumer("c2", 0)},
+		},
+		{
+			name:    "exceeding Consumer quota",
+			flags:   quota("2", "0"),
+			others:  []*kafkainternals.Consumer{newConsumer("c0", time.Hour), newConsumer("c2", 2*time.Hour)},
+			wantErr: true,
+		},
+		{
+			name:   "ignore Consumers being deleted",
+			flags:  quota("1", "0"),
+			others: []*kafkainternals.Consumer{deleting},
+		},
+		{
+			name:   "within egress quota",
+			flags:  quota("0", "3"),
+			others: []*kafkainternals.Consumer{routed},
+		},
+		{
+			name:    "exceeding egress quota",
+			flags:   quota("0", "2"),
+			others:  []*kafkainternals.Consumer{routed},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newConsumer("c1", time.Minute)
+
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, o := range append(tt.others, c) {
+				if err := indexer.Add(o); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			r := &Reconciler{
+				KafkaFeatureFlags: tt.flags,
+				ConsumerLister:    kafkainternalslisters.NewConsumerLister(indexer),
+			}
+
+			err := r.reconcileNamespaceQuota(c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("want error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEgressesOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes []kafkainternals.TopicRoute
+		group  *string
+		want   int
+	}{
+		{
+			name: "no routes",
+			want: 1,
+		},
+		{
+			name:   "some topics routed",
+			routes: []kafkainternals.TopicRoute{{Topic: "t1"}},
+			want:   2,
+		},
+		{
+			name:   "all topics routed",
+			routes: []kafkainternals.TopicRoute{{Topic: "t1"}, {Topic: "t2"}},
+			want:   2,
+		},
+		{
+			name:   "additional group",
+			routes: []kafkainternals.TopicRoute{{Topic: "t1"}},
+			group:  pointer.String("g2"),
+			want:   4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &kafkainternals.Consumer{
+				Spec: kafkainternals.ConsumerSpec{
+					Topics:      []string{"t1", "t2"},
+					TopicRoutes: tt.routes,
+					Configs:     kafkainternals.ConsumerConfigs{AdditionalGroupID: tt.group},
+				},
+			}
+			if got := egressesOf(c); got != tt.want {
+				t.Errorf("want %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+// enqueueConsumersBoundTo enqueues the Consumers, other than the given one, bound to the given pod.
+//
+// It's used when the contract ConfigMap of the pod is (re)created empty, so that the bound Consumers
+// add their egresses back promptly instead of waiting for their next reconciliation.
+func (r *Reconciler) enqueueConsumersBoundTo(ctx context.Context, c *kafkainternals.Consumer, p *corev1.Pod) {
+	if r.EnqueueConsumer == nil || r.ConsumerLister == nil {
+		return
+	}
+
+	consumers, err := r.ConsumerLister.List(labels.Everything())
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Debug("Failed to list consumers bound to pod",
+			zap.String("pod", p.GetNamespace()+"/"+p.GetName()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	for _, other := range consumers {
+		if other.GetUID() == c.GetUID() || other.GetDeletionTimestamp() != nil || other.Spec.PodBind == nil {
+			continue
+		}
+		if other.Spec.PodBind.PodName == p.GetName() && other.Spec.PodBind.PodNamespace == p.GetNamespace() {
+			r.EnqueueConsumer(other)
+		}
+	}
+}
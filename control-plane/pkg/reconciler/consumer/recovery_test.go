@@ -0,0 +1,134 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client/fake"
+	fakepodinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/fake"
+	"knative.dev/pkg/logging"
+	. "knative.dev/pkg/reconciler/testing"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	kafkainternalslisters "knative.dev/eventing-kafka-broker/control-plane/pkg/client/listers/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/contract"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
+	. "knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/testing"
+)
+
+func TestScheduleRecreatedConfigMapEnqueuesBoundConsumers(t *testing.T) {
+	tests := []struct {
+		name        string
+		configMap   *contract.Contract
+		wantEnqueue []string
+	}{
+		{
+			name:        "config map deleted",
+			wantEnqueue: []string{"c2"},
+		},
+		{
+			name: "config map with contract",
+			configMap: &contract.Contract{
+				Resources: []*contract.Resource{{Uid: "c2", Egresses: []*contract.Egress{{Uid: "c2"}}}},
+			},
+			wantEnqueue: nil,
+		},
+		{
+			name:        "config map with empty contract",
+			configMap:   &contract.Contract{},
+			wantEnqueue: nil,
+		},
+	}
+
+	newConsumer := func(name, podName string, deleted bool) *kafkainternals.Consumer {
+		c := &kafkainternals.Consumer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(name)},
+			Spec: kafkainternals.ConsumerSpec{
+				PodBind: &kafkainternals.PodBind{PodName: podName, PodNamespace: SystemNamespace},
+			},
+		}
+		if deleted {
+			c.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+		return c
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := SetupFakeContext(t, SetUpInformerSelector)
+
+			pod := NewDispatcherPod("p1", PodRunning())
+			_ = fakepodinformer.Get(ctx).Informer().GetIndexer().Add(pod)
+			if _, err := kubeclient.Get(ctx).CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			if tt.configMap != nil {
+				cm := NewConfigMapFromContract(tt.configMap, pod.Namespace, pod.Name, base.Json).(*corev1.ConfigMap)
+				if _, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			c := newConsumer("c1", pod.Name, false)
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, o := range []*kafkainternals.Consumer{
+				c,
+				newConsumer("c2", pod.Name, false),
+				newConsumer("c3", "p2", false),
+				newConsumer("c4", pod.Name, true),
+			} {
+				if err := indexer.Add(o); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var enqueued []string
+			r := &Reconciler{
+				SerDe:                      contract.FormatSerDe{Format: contract.Json},
+				ContractBackup:             base.NoopContractBackup{},
+				KafkaFeatureFlags:          configapis.DefaultFeaturesConfig(),
+				ConsumerLister:             kafkainternalslisters.NewConsumerLister(indexer),
+				PodLister:                  fakepodinformer.Get(ctx).Lister(),
+				KubeClient:                 kubeclient.Get(ctx),
+				TrustBundleConfigMapLister: corelisters.NewConfigMapLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})).ConfigMaps(SystemNamespace),
+				EnqueueConsumer: func(obj interface{}) {
+					enqueued = append(enqueued, obj.(*kafkainternals.Consumer).GetName())
+				},
+			}
+
+			bound, err := r.schedule(ctx, logging.FromContext(ctx).Desugar(), c, addResource(&contract.Resource{Uid: "c1"}, false), IsPodNotRunning)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bound {
+				t.Error("want consumer bound")
+			}
+			if !slices.Equal(enqueued, tt.wantEnqueue) {
+				t.Errorf("want enqueued %v, got %v", tt.wantEnqueue, enqueued)
+			}
+		})
+	}
+}
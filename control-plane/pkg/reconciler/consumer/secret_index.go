@@ -0,0 +1,112 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/kmeta"
+
+	bindings "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/bindings/v1"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+// SecretIndex is the name of the Consumer informer index keyed by the
+// namespace/name of the secrets referenced by a Consumer.
+const SecretIndex = "consumer-secrets"
+
+// secretIndexFunc returns the namespace/name keys of the auth secrets referenced by the given Consumer,
+// including the auth secrets of the Kafka cluster its topic replies are produced to, and the secrets
+// holding its delivery signing key and its subscriber client certificate.
+func secretIndexFunc(obj interface{}) ([]string, error) {
+	c, ok := obj.(*kafkainternals.Consumer)
+	if !ok {
+		return nil, nil
+	}
+
+	var keys []string
+	if auth := c.Spec.Auth; auth != nil {
+		if auth.SecretSpec.HasSecret() {
+			keys = append(keys, types.NamespacedName{Namespace: auth.SecretSpec.Ref.Namespace, Name: auth.SecretSpec.Ref.Name}.String())
+		} else {
+			keys = appendNetSpecSecretKeys(keys, c.GetNamespace(), auth.NetSpec)
+		}
+	}
+	if c.Spec.Reply != nil && c.Spec.Reply.TopicReply != nil {
+		keys = appendNetSpecSecretKeys(keys, c.GetNamespace(), c.Spec.Reply.TopicReply.NetSpec)
+	}
+	if c.Spec.Delivery != nil && c.Spec.Delivery.Signing != nil {
+		keys = appendSecretKey(keys, c.GetNamespace(), c.Spec.Delivery.Signing.SecretName)
+	}
+	if c.Spec.SubscriberClientCertSecretRef != nil {
+		keys = appendSecretKey(keys, c.GetNamespace(), c.Spec.SubscriberClientCertSecretRef.Name)
+	}
+	return keys, nil
+}
+
+// appendNetSpecSecretKeys appends to the given keys the namespace/name keys of the secrets referenced
+// by the given net spec, which are in the given namespace.
+func appendNetSpecSecretKeys(keys []string, namespace string, netSpec *bindings.KafkaNetSpec) []string {
+	if netSpec == nil {
+		return keys
+	}
+	for _, s := range []*corev1.SecretKeySelector{
+		netSpec.TLS.Key.SecretKeyRef,
+		netSpec.TLS.Cert.SecretKeyRef,
+		netSpec.TLS.CACert.SecretKeyRef,
+		netSpec.SASL.Password.SecretKeyRef,
+		netSpec.SASL.User.SecretKeyRef,
+		netSpec.SASL.Type.SecretKeyRef,
+	} {
+		if s == nil || s.Name == "" {
+			continue
+		}
+		keys = appendSecretKey(keys, namespace, s.Name)
+	}
+	return keys
+}
+
+// appendSecretKey appends to the given keys the namespace/name key of the given secret, unless it's
+// already in the keys.
+func appendSecretKey(keys []string, namespace, name string) []string {
+	key := types.NamespacedName{Namespace: namespace, Name: name}.String()
+	if slices.Contains(keys, key) {
+		return keys
+	}
+	return append(keys, key)
+}
+
+// enqueueConsumersReferencingSecret returns an event handler enqueueing the Consumers
+// that reference the changed secret, according to the SecretIndex of the given indexer.
+func enqueueConsumersReferencingSecret(indexer cache.Indexer, enqueue func(interface{})) func(obj interface{}) {
+	return func(obj interface{}) {
+		secret, err := kmeta.DeletionHandlingAccessor(obj)
+		if err != nil {
+			return
+		}
+		consumers, err := indexer.ByIndex(SecretIndex, types.NamespacedName{Namespace: secret.GetNamespace(), Name: secret.GetName()}.String())
+		if err != nil {
+			return
+		}
+		for _, c := range consumers {
+			enqueue(c)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	pointer "knative.dev/pkg/ptr"
+
+	bindings "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/bindings/v1"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+func TestSecretIndex(t *testing.T) {
+	secretSpec := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "secret-spec"},
+		Spec: kafkainternals.ConsumerSpec{
+			Auth: &kafkainternals.Auth{
+				SecretSpec: &kafkainternals.SecretSpec{
+					Ref: &kafkainternals.SecretReference{Namespace: "ns-secrets", Name: "s1"},
+				},
+			},
+		},
+	}
+	netSpec := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "net-spec"},
+		Spec: kafkainternals.ConsumerSpec{
+			Auth: &kafkainternals.Auth{
+				NetSpec: &bindings.KafkaNetSpec{
+					SASL: bindings.KafkaSASLSpec{
+						User:     secretValue("s2"),
+						Password: secretValue("s2"),
+					},
+					TLS: bindings.KafkaTLSSpec{
+						CACert: secretValue("s3"),
+					},
+				},
+			},
+		},
+	}
+	replyNetSpec := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "reply-net-spec"},
+		Spec: kafkainternals.ConsumerSpec{
+			Reply: &kafkainternals.ReplyStrategy{
+				TopicReply: &kafkainternals.TopicReply{
+					Enabled:          true,
+					BootstrapServers: pointer.String("local:9092"),
+					NetSpec: &bindings.KafkaNetSpec{
+						SASL: bindings.KafkaSASLSpec{Password: secretValue("s4")},
+					},
+				},
+			},
+		},
+	}
+	signing := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "signing"},
+		Spec: kafkainternals.ConsumerSpec{
+			Delivery: &kafkainternals.DeliverySpec{
+				Signing: &kafkainternals.DeliverySigning{SecretName: "s5", SecretKey: "key"},
+			},
+		},
+	}
+	clientCert := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-cert"},
+		Spec: kafkainternals.ConsumerSpec{
+			SubscriberClientCertSecretRef: &corev1.LocalObjectReference{Name: "s6"},
+		},
+	}
+	noAuth := &kafkainternals.Consumer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "no-auth"},
+	}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{SecretIndex: secretIndexFunc})
+	for _, c := range []*kafkainternals.Consumer{secretSpec, netSpec, replyNetSpec, signing, clientCert, noAuth} {
+		if err := indexer.Add(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		secret *corev1.Secret
+		want   []string
+	}{
+		{
+			name:   "secret spec",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-secrets", Name: "s1"}},
+			want:   []string{"secret-spec"},
+		},
+		{
+			name:   "net spec",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "s2"}},
+			want:   []string{"net-spec"},
+		},
+		{
+			name:   "net spec TLS",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "s3"}},
+			want:   []string{"net-spec"},
+		},
+		{
+			name:   "reply net spec",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "s4"}},
+			want:   []string{"reply-net-spec"},
+		},
+		{
+			name:   "delivery signing",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "s5"}},
+			want:   []string{"signing"},
+		},
+		{
+			name:   "subscriber client certificate",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "s6"}},
+			want:   []string{"client-cert"},
+		},
+		{
+			name:   "unreferenced",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "s1"}},
+			want:   nil,
+		},
+		{
+			name:   "deleted",
+			secret: nil,
+			want:   []string{"secret-spec"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj interface{} = tt.secret
+			if tt.secret == nil {
+				obj = cache.DeletedFinalStateUnknown{
+					Key: "ns-secrets/s1",
+					Obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-secrets", Name: "s1"}},
+				}
+			}
+
+			var got []string
+			enqueueConsumersReferencingSecret(indexer, func(obj interface{}) {
+				got = append(got, obj.(*kafkainternals.Consumer).Name)
+			})(obj)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func secretValue(name string) bindings.SecretValueFromSource {
+	return bindings.SecretValueFromSource{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  "key",
+		},
+	}
+}
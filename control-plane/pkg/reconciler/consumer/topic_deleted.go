@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+// reconcileTopicDeleted detects, when the Consumer has an OnTopicDeleted policy, the Consumer topics
+// that don't exist and reacts according to the policy:
+//   - wait: the Consumer is marked and the dispatcher waits for the topics to be created again
+//   - error: the Consumer is marked and the reconciliation fails
+//   - recreate: the topics are created again
+func (r *Reconciler) reconcileTopicDeleted(ctx context.Context, c *kafkainternals.Consumer, topics *consumerTopics) error {
+	if c.Spec.Configs.OnTopicDeleted == nil {
+		c.ClearSourceTopicMissing()
+		return nil
+	}
+
+	metadata, err := topics.describe(ctx)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, m := range metadata {
+		if errors.Is(m.Err, sarama.ErrUnknownTopicOrPartition) {
+			missing = append(missing, m.Name)
+			continue
+		}
+		if m.Err != sarama.ErrNoError {
+			return fmt.Errorf("failed to describe topic %s: %w", m.Name, m.Err)
+		}
+	}
+	if len(missing) == 0 {
+		c.ClearSourceTopicMissing()
+		return nil
+	}
+
+	switch *c.Spec.Configs.OnTopicDeleted {
+	case kafkainternals.OnTopicDeletedError:
+		c.MarkSourceTopicMissing("topics %v not found", missing)
+		return fmt.Errorf("topics %v not found", missing)
+	case kafkainternals.OnTopicDeletedRecreate:
+		partitions := int32(1)
+		if c.Spec.TopicPartitions != nil {
+			partitions = *c.Spec.TopicPartitions
+		}
+		kafkaClusterAdminClient, err := topics.clusterAdmin(ctx)
+		if err != nil {
+			return err
+		}
+		for _, topic := range missing {
+			// A replication factor of -1 uses the Kafka broker default.
+			detail := &sarama.TopicDetail{NumPartitions: partitions, ReplicationFactor: -1}
+			if err := kafkaClusterAdminClient.CreateTopic(topic, detail, false); err != nil && !errors.Is(err, sarama.ErrTopicAlreadyExists) {
+				c.MarkSourceTopicMissing("topics %v not found", missing)
+				return fmt.Errorf("failed to recreate topic %s: %w", topic, err)
+			}
+			// The recreated topics are described again by the next checks.
+			topics.invalidate()
+			logging.FromContext(ctx).Desugar().Info("Recreated deleted topic",
+				zap.String("topic", topic),
+				zap.Int32("partitions", partitions),
+			)
+		}
+		c.ClearSourceTopicMissing()
+	default:
+		c.MarkSourceTopicMissing("topics %v not found, waiting for them to be created", missing)
+	}
+	return nil
+}
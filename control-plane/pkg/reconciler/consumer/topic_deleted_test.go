@@ -0,0 +1,134 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pointer "knative.dev/pkg/ptr"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
+)
+
+func TestReconcileTopicDeleted(t *testing.T) {
+	existing := []*sarama.TopicMetadata{{Name: "t1", Partitions: make([]*sarama.PartitionMetadata, 2)}}
+	missing := []*sarama.TopicMetadata{{Name: "t1", Err: sarama.ErrUnknownTopicOrPartition}}
+
+	tests := []struct {
+		name          string
+		policy        *string
+		partitions    *int32
+		admin         *kafkatesting.MockKafkaClusterAdmin
+		wantErr       bool
+		wantCondition bool
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name:   "topic exists",
+			policy: pointer.String(kafkainternals.OnTopicDeletedError),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: existing,
+			},
+		},
+		{
+			name:   "wait",
+			policy: pointer.String(kafkainternals.OnTopicDeletedWait),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: missing,
+			},
+			wantCondition: true,
+		},
+		{
+			name:   "error",
+			policy: pointer.String(kafkainternals.OnTopicDeletedError),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: missing,
+			},
+			wantErr:       true,
+			wantCondition: true,
+		},
+		{
+			name:       "recreate",
+			policy:     pointer.String(kafkainternals.OnTopicDeletedRecreate),
+			partitions: pointer.Int32(4),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: missing,
+				ExpectedTopicName:                      "t1",
+				ExpectedTopicDetail:                    sarama.TopicDetail{NumPartitions: 4, ReplicationFactor: -1},
+			},
+		},
+		{
+			name:   "recreate failure",
+			policy: pointer.String(kafkainternals.OnTopicDeletedRecreate),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: missing,
+				ExpectedTopicName:                      "t1",
+				ExpectedTopicDetail:                    sarama.TopicDetail{NumPartitions: 1, ReplicationFactor: -1},
+				ErrorOnCreateTopic:                     errors.New("failed"),
+			},
+			wantErr:       true,
+			wantCondition: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &kafkainternals.Consumer{
+				ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "ns"},
+				Spec: kafkainternals.ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: kafkainternals.ConsumerConfigs{
+						Configs:        map[string]string{"bootstrap.servers": "kafka:9092"},
+						OnTopicDeleted: tt.policy,
+					},
+					TopicPartitions: tt.partitions,
+				},
+			}
+			r := &Reconciler{
+				GetKafkaClusterAdmin: func(_ context.Context, _ []string, _ *corev1.Secret) (sarama.ClusterAdmin, error) {
+					if tt.admin == nil {
+						t.Fatal("unexpected Kafka cluster admin creation")
+					}
+					tt.admin.T = t
+					return tt.admin, nil
+				},
+			}
+
+			err := r.reconcileTopicDeleted(context.Background(), c, r.newConsumerTopics(c))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reconcileTopicDeleted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			cond := c.Status.GetCondition(kafkainternals.ConsumerConditionSourceTopicMissing)
+			if got := cond != nil && cond.IsTrue(); got != tt.wantCondition {
+				t.Errorf("want SourceTopicMissing condition %v, got %v", tt.wantCondition, cond)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+)
+
+// reconcileTopicPartitions increases the partitions of the Consumer topics up to the desired number of
+// partitions, when partition expansion is enabled.
+// Partitions are never decreased.
+func (r *Reconciler) reconcileTopicPartitions(ctx context.Context, c *kafkainternals.Consumer, topics *consumerTopics) error {
+	if c.Spec.TopicPartitions == nil || !r.KafkaFeatureFlags.IsControllerPartitionExpansionEnabled() {
+		return nil
+	}
+	desired := *c.Spec.TopicPartitions
+
+	metadata, err := topics.describe(ctx)
+	if err != nil {
+		return err
+	}
+	kafkaClusterAdminClient, err := topics.clusterAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range metadata {
+		if m.Err != sarama.ErrNoError {
+			return fmt.Errorf("failed to describe topic %s: %w", m.Name, m.Err)
+		}
+
+		current := int32(len(m.Partitions))
+		if current >= desired {
+			continue
+		}
+
+		if err := kafkaClusterAdminClient.CreatePartitions(m.Name, desired, nil, false); err != nil {
+			return fmt.Errorf("failed to expand topic %s partitions from %d to %d: %w", m.Name, current, desired, err)
+		}
+
+		// The expanded topics are described again by the next checks.
+		topics.invalidate()
+		logging.FromContext(ctx).Desugar().Info("Expanded topic partitions",
+			zap.String("topic", m.Name),
+			zap.Int32("from", current),
+			zap.Int32("to", desired),
+		)
+		c.MarkPartitionsExpanded(m.Name, current, desired)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pointer "knative.dev/pkg/ptr"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
+)
+
+func TestReconcileTopicPartitions(t *testing.T) {
+	enabled, err := configapis.NewFeaturesConfigFromMap(&corev1.ConfigMap{
+		Data: map[string]string{"controller-partition-expansion": "enabled"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	topicMetadata := func(partitions int) []*sarama.TopicMetadata {
+		return []*sarama.TopicMetadata{{
+			Name:       "t1",
+			Partitions: make([]*sarama.PartitionMetadata, partitions),
+		}}
+	}
+
+	tests := []struct {
+		name           string
+		flags          *configapis.KafkaFeatureFlags
+		partitions     *int32
+		admin          *kafkatesting.MockKafkaClusterAdmin
+		wantErr        bool
+		wantExpansions []kafkainternals.PartitionExpansion
+	}{
+		{
+			name:       "expand partitions",
+			flags:      enabled,
+			partitions: pointer.Int32(4),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: topicMetadata(2),
+				ExpectedTopicName:                      "t1",
+				ExpectedPartitionsCount:                4,
+			},
+			wantExpansions: []kafkainternals.PartitionExpansion{
+				{Topic: "t1", FromPartitions: 2, ToPartitions: 4},
+			},
+		},
+		{
+			name:       "never decrease partitions",
+			flags:      enabled,
+			partitions: pointer.Int32(2),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: topicMetadata(4),
+			},
+		},
+		{
+			name:       "expansion failure",
+			flags:      enabled,
+			partitions: pointer.Int32(4),
+			admin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                         []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: topicMetadata(2),
+				ExpectedTopicName:                      "t1",
+				ExpectedPartitionsCount:                4,
+				ErrorOnCreatePartitions:                errors.New("failed"),
+			},
+			wantErr: true,
+		},
+		{
+			name:       "feature disabled",
+			flags:      configapis.DefaultFeaturesConfig(),
+			partitions: pointer.Int32(4),
+		},
+		{
+			name:  "no desired partitions",
+			flags: enabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &kafkainternals.Consumer{
+				ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "ns"},
+				Spec: kafkainternals.ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: kafkainternals.ConsumerConfigs{
+						Configs: map[string]string{"bootstrap.servers": "kafka:9092"},
+					},
+					TopicPartitions: tt.partitions,
+				},
+			}
+			r := &Reconciler{
+				KafkaFeatureFlags: tt.flags,
+				GetKafkaClusterAdmin: func(_ context.Context, _ []string, _ *corev1.Secret) (sarama.ClusterAdmin, error) {
+					if tt.admin == nil {
+						t.Fatal("unexpected Kafka cluster admin creation")
+					}
+					tt.admin.T = t
+					return tt.admin, nil
+				},
+			}
+
+			err := r.reconcileTopicPartitions(context.Background(), c, r.newConsumerTopics(c))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reconcileTopicPartitions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantExpansions, c.Status.PartitionExpansions); diff != "" {
+				t.Errorf("unexpected partition expansions (-want +got) %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/kafka"
+)
+
+// consumerTopics shares, across the checks of the Consumer topics run by a reconciliation, the Kafka
+// cluster admin and the metadata of the topics, which are only fetched when a check needs them.
+type consumerTopics struct {
+	r *Reconciler
+	c *kafkainternals.Consumer
+
+	admin    sarama.ClusterAdmin
+	adminErr error
+
+	described   bool
+	metadata    []*sarama.TopicMetadata
+	metadataErr error
+}
+
+func (r *Reconciler) newConsumerTopics(c *kafkainternals.Consumer) *consumerTopics {
+	return &consumerTopics{r: r, c: c}
+}
+
+// clusterAdmin returns the Kafka cluster admin of the Consumer, resolving the auth secret on first use.
+func (t *consumerTopics) clusterAdmin(ctx context.Context) (sarama.ClusterAdmin, error) {
+	if t.admin != nil || t.adminErr != nil {
+		return t.admin, t.adminErr
+	}
+
+	secret, err := t.r.newAuthSecret(ctx, t.c)
+	if err != nil {
+		t.adminErr = fmt.Errorf("failed to get secret for Kafka cluster auth: %w", err)
+		return nil, t.adminErr
+	}
+
+	bootstrapServers := kafka.BootstrapServersArray(t.c.Spec.Configs.Configs["bootstrap.servers"])
+	t.admin, err = t.r.GetKafkaClusterAdmin(ctx, bootstrapServers, secret)
+	if err != nil {
+		t.adminErr = fmt.Errorf("cannot obtain Kafka cluster admin, %w", err)
+	}
+	return t.admin, t.adminErr
+}
+
+// describe returns the metadata of the Consumer topics, they're described on first use and again
+// after invalidate.
+func (t *consumerTopics) describe(ctx context.Context) ([]*sarama.TopicMetadata, error) {
+	if t.described {
+		return t.metadata, t.metadataErr
+	}
+	t.described = true
+
+	admin, err := t.clusterAdmin(ctx)
+	if err != nil {
+		t.metadata, t.metadataErr = nil, err
+		return nil, err
+	}
+	t.metadata, err = admin.DescribeTopics(t.c.Spec.Topics)
+	if err != nil {
+		t.metadataErr = fmt.Errorf("failed to describe topics %v: %w", t.c.Spec.Topics, err)
+	} else {
+		t.metadataErr = nil
+	}
+	return t.metadata, t.metadataErr
+}
+
+// invalidate makes the next describe fetch the metadata again, after the topics have been changed.
+func (t *consumerTopics) invalidate() {
+	t.described = false
+}
+
+// partitions returns the total number of partitions of the Consumer topics.
+func (t *consumerTopics) partitions(ctx context.Context) (int32, error) {
+	metadata, err := t.describe(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var partitions int32
+	for _, m := range metadata {
+		if m.Err != sarama.ErrNoError {
+			return 0, fmt.Errorf("failed to describe topic %s: %w", m.Name, m.Err)
+		}
+		partitions += int32(len(m.Partitions))
+	}
+	return partitions, nil
+}
+
+// close closes the Kafka cluster admin, when it has been obtained.
+func (t *consumerTopics) close() {
+	if t.admin != nil {
+		_ = t.admin.Close()
+	}
+}
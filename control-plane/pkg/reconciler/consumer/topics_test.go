@@ -0,0 +1,140 @@
+/*
+ * Copyright 2025 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	pointer "knative.dev/pkg/ptr"
+
+	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
+	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	sources "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1"
+	kafkainternalslisters "knative.dev/eventing-kafka-broker/control-plane/pkg/client/listers/internalskafkaeventing/v1alpha1"
+	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
+)
+
+// countingClusterAdmin counts the topics descriptions made through the wrapped cluster admin.
+type countingClusterAdmin struct {
+	sarama.ClusterAdmin
+	describeTopics int
+}
+
+func (a *countingClusterAdmin) DescribeTopics(topics []string) ([]*sarama.TopicMetadata, error) {
+	a.describeTopics++
+	return a.ClusterAdmin.DescribeTopics(topics)
+}
+
+func TestConsumerTopicsSharedByChecks(t *testing.T) {
+	flags, err := configapis.NewFeaturesConfigFromMap(&corev1.ConfigMap{
+		Data: map[string]string{
+			"controller-partition-expansion":              "enabled",
+			"controller-vreplicas-oversubscription-check": "enabled",
+			"controller-overprovisioned-replicas-check":   "enabled",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name               string
+		partitions         int32
+		wantDescribeTopics int
+	}{
+		{
+			name:               "topics described once",
+			partitions:         4,
+			wantDescribeTopics: 1,
+		},
+		{
+			name:               "topics described again after the partitions expansion",
+			partitions:         8,
+			wantDescribeTopics: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &kafkainternals.Consumer{
+				ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "ns", UID: "c"},
+				Spec: kafkainternals.ConsumerSpec{
+					Topics: []string{"t1"},
+					Configs: kafkainternals.ConsumerConfigs{
+						Configs:        map[string]string{"bootstrap.servers": "kafka:9092", "group.id": "g"},
+						OnTopicDeleted: pointer.String(kafkainternals.OnTopicDeletedWait),
+					},
+					Delivery:        &kafkainternals.DeliverySpec{Ordering: sources.Ordered},
+					TopicPartitions: pointer.Int32(tt.partitions),
+					VReplicas:       pointer.Int32(2),
+					PodBind:         &kafkainternals.PodBind{PodName: "p", PodNamespace: "ns"},
+				},
+			}
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			if err := indexer.Add(c); err != nil {
+				t.Fatal(err)
+			}
+
+			mock := &kafkatesting.MockKafkaClusterAdmin{
+				T:              t,
+				ExpectedTopics: []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{{
+					Name:       "t1",
+					Partitions: make([]*sarama.PartitionMetadata, 4),
+				}},
+				ExpectedTopicName:       "t1",
+				ExpectedPartitionsCount: tt.partitions,
+			}
+			admin := &countingClusterAdmin{ClusterAdmin: mock}
+			admins := 0
+			r := &Reconciler{
+				KafkaFeatureFlags: flags,
+				ConsumerLister:    kafkainternalslisters.NewConsumerLister(indexer),
+				GetKafkaClusterAdmin: func(_ context.Context, _ []string, _ *corev1.Secret) (sarama.ClusterAdmin, error) {
+					admins++
+					return admin, nil
+				},
+			}
+
+			ctx := context.Background()
+			topics := r.newConsumerTopics(c)
+			if err := r.reconcileTopicDeleted(ctx, c, topics); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.reconcileTopicPartitions(ctx, c, topics); err != nil {
+				t.Fatal(err)
+			}
+			r.reconcileVReplicasOversubscription(ctx, c, topics)
+			r.reconcileOverProvisionedReplicas(ctx, c, topics)
+			topics.close()
+
+			if admins != 1 {
+				t.Errorf("want 1 Kafka cluster admin, got %d", admins)
+			}
+			if admin.describeTopics != tt.wantDescribeTopics {
+				t.Errorf("want %d topics descriptions, got %d", tt.wantDescribeTopics, admin.describeTopics)
+			}
+			if !mock.ExpectedClose {
+				t.Error("want Kafka cluster admin closed")
+			}
+		})
+	}
+}
@@ -67,6 +67,7 @@ func NewController(ctx context.Context, watcher configmap.Watcher, configs *conf
 			DataPlaneConfigMapNamespace: configs.DataPlaneConfigMapNamespace,
 			ContractConfigMapName:       configs.ContractConfigMapName,
 			ContractConfigMapFormat:     configs.ContractConfigMapFormat,
+			ContractBackup:              base.NewContractBackup(configs.ContractBackupDirectory),
 			DataPlaneNamespace:          configs.SystemNamespace,
 			ReceiverLabel:               base.SinkReceiverLabel,
 		},
@@ -82,7 +83,7 @@ func NewController(ctx context.Context, watcher configmap.Watcher, configs *conf
 		reconciler.GetKafkaClusterAdmin = clientPool.GetClusterAdmin
 	}
 
-	_, err := reconciler.GetOrCreateDataPlaneConfigMap(ctx)
+	_, _, err := reconciler.GetOrCreateDataPlaneConfigMap(ctx)
 	if err != nil {
 		logger.Fatal("Failed to get or create data plane config map",
 			zap.String("configmap", configs.DataPlaneConfigMapAsString()),
@@ -1975,6 +1975,7 @@ func useTable(t *testing.T, table TableTest, env *config.Env) {
 				ContractConfigMapFormat:     env.ContractConfigMapFormat,
 				DataPlaneNamespace:          env.SystemNamespace,
 				ReceiverLabel:               base.SinkReceiverLabel,
+				ContractBackup:              base.NoopContractBackup{},
 			},
 			ConfigMapLister:   listers.GetConfigMapLister(),
 			EventPolicyLister: listers.GetEventPolicyLister(),
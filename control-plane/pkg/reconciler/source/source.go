@@ -127,6 +127,12 @@ func (r Reconciler) FinalizeKind(ctx context.Context, ks *sources.KafkaSource) r
 }
 
 func (r Reconciler) reconcileConsumerGroup(ctx context.Context, ks *sources.KafkaSource) (*internalscg.ConsumerGroup, error) {
+	// KafkaSources admitted before the extension names were validated would otherwise produce
+	// Consumers rejected by the API server.
+	if err := sources.ValidateCloudEventOverrides(ks.Spec.CloudEventOverrides); err != nil {
+		return nil, fmt.Errorf("invalid spec.ceOverrides: %w", err)
+	}
+
 	var deliverySpec *internalscg.DeliverySpec
 	deliveryOrder := DefaultDeliveryOrder
 	if ks.Spec.Ordering != nil {
@@ -65,6 +65,8 @@ const (
 )
 
 var (
+	invalidCEOverridesErr = "invalid spec.ceOverrides: invalid key name \"Foo\": extensions\nexpected a CloudEvent attribute name made of lowercase letters and digits"
+
 	finalizerUpdatedEvent = Eventf(
 		corev1.EventTypeNormal,
 		"FinalizerUpdate",
@@ -710,6 +712,37 @@ func TestReconcileKind(t *testing.T) {
 				finalizerUpdatedEvent,
 			},
 		},
+		{
+			Name: "Invalid ce overrides extension name",
+			Objects: []runtime.Object{
+				NewSourceSinkObject(),
+				NewSource(WithCloudEventOverrides(&duckv1.CloudEventOverrides{
+					Extensions: map[string]string{"Foo": "foo"},
+				})),
+			},
+			Key:     testKey,
+			WantErr: true,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewSource(
+						WithCloudEventOverrides(&duckv1.CloudEventOverrides{
+							Extensions: map[string]string{"Foo": "foo"},
+						}),
+						StatusSourceConditionsInitialized(),
+						StatusSourceConsumerGroupFailed("failed to reconcile consumer group", invalidCEOverridesErr),
+						StatusSourceSelector(),
+						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+					),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+				Eventf(corev1.EventTypeWarning, "InternalError", invalidCEOverridesErr),
+			},
+		},
 		{
 			Name: "Reconciled normal - with autoscaling annotations",
 			Objects: []runtime.Object{
@@ -1644,6 +1677,13 @@ func StatusSourceConsumerGroup() KRShapedOption {
 	}
 }
 
+func StatusSourceConditionsInitialized() KRShapedOption {
+	return func(obj duckv1.KRShaped) {
+		ks := obj.(*sources.KafkaSource)
+		ks.GetConditionSet().Manage(ks.GetStatus()).InitializeConditions()
+	}
+}
+
 func StatusSourceConsumerGroupFailed(reason string, msg string) KRShapedOption {
 	return func(obj duckv1.KRShaped) {
 		ks := obj.(*sources.KafkaSource)
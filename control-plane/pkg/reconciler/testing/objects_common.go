@@ -594,6 +594,9 @@ func NewDispatcherPod(name string, options ...PodOption) *corev1.Pod {
 			Name:      name,
 			Namespace: SystemNamespace,
 			UID:       DispatcherPodUUID,
+			Labels: map[string]string{
+				kafkaeventing.DataPlanePodKindLabelKey: kafkaeventing.DispatcherPodKindLabelValue,
+			},
 		},
 		Spec: corev1.PodSpec{
 			Volumes: []corev1.Volume{{
@@ -81,6 +81,7 @@ func NewController(ctx context.Context, watcher configmap.Watcher, configs *conf
 			DataPlaneConfigConfigMapName: configs.DataPlaneConfigConfigMapName,
 			ContractConfigMapName:        configs.ContractConfigMapName,
 			ContractConfigMapFormat:      configs.ContractConfigMapFormat,
+			ContractBackup:               base.NewContractBackup(configs.ContractBackupDirectory),
 			DataPlaneNamespace:           configs.SystemNamespace,
 			DispatcherLabel:              base.BrokerDispatcherLabel,
 			ReceiverLabel:                base.BrokerReceiverLabel,
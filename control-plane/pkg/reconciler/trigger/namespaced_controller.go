@@ -73,6 +73,7 @@ func NewNamespacedController(ctx context.Context, watcher configmap.Watcher, con
 			DataPlaneConfigConfigMapName: configs.DataPlaneConfigConfigMapName,
 			ContractConfigMapName:        configs.ContractConfigMapName,
 			ContractConfigMapFormat:      configs.ContractConfigMapFormat,
+			ContractBackup:               base.NewContractBackup(configs.ContractBackupDirectory),
 			DataPlaneNamespace:           configs.SystemNamespace,
 			DispatcherLabel:              base.BrokerDispatcherLabel,
 			ReceiverLabel:                base.BrokerReceiverLabel,
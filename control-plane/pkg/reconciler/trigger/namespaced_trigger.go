@@ -73,6 +73,7 @@ func (r *NamespacedReconciler) createReconcilerForTriggerInstance(trigger *event
 			Tracker:                      r.Tracker,
 			ContractConfigMapName:        r.ContractConfigMapName,
 			ContractConfigMapFormat:      r.ContractConfigMapFormat,
+			ContractBackup:               r.ContractBackup,
 			DataPlaneConfigConfigMapName: r.DataPlaneConfigConfigMapName,
 			DispatcherLabel:              r.DispatcherLabel,
 			ReceiverLabel:                r.ReceiverLabel,
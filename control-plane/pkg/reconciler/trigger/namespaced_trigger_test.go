@@ -163,6 +163,7 @@ func useNamespacedTable(t *testing.T, table TableTest, env *config.Env) {
 				DataPlaneNamespace:           env.SystemNamespace,
 				DispatcherLabel:              base.BrokerDispatcherLabel,
 				ReceiverLabel:                base.BrokerReceiverLabel,
+				ContractBackup:               base.NoopContractBackup{},
 			},
 			FlagsHolder: &FlagsHolder{
 				Flags: nil,
@@ -158,7 +158,7 @@ func (r *Reconciler) reconcileKind(ctx context.Context, trigger *eventing.Trigge
 	}
 
 	// Get data plane config map.
-	contractConfigMap, err := r.GetOrCreateDataPlaneConfigMap(ctx)
+	contractConfigMap, _, err := r.GetOrCreateDataPlaneConfigMap(ctx)
 	if err != nil {
 		return statusConditionManager.failedToGetDataPlaneConfigMap(err)
 	}
@@ -240,7 +240,7 @@ func (r *Reconciler) finalizeKind(ctx context.Context, trigger *eventing.Trigger
 	}
 
 	// Get data plane config map.
-	dataPlaneConfigMap, err := r.GetOrCreateDataPlaneConfigMap(ctx)
+	dataPlaneConfigMap, _, err := r.GetOrCreateDataPlaneConfigMap(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get data plane config map %s: %w", r.Env.DataPlaneConfigMapAsString(), err)
 	}
@@ -361,6 +361,9 @@ func (r *Reconciler) reconcileTriggerEgress(ctx context.Context, broker *eventin
 	}
 	// Merge Broker and Trigger egress configuration prioritizing the Trigger configuration.
 	egress.EgressConfig = coreconfig.MergeEgressConfig(triggerEgressConfig, brokerEgressConfig)
+	if err := coreconfig.ValidateRetryDuration(egress.EgressConfig, r.KafkaFeatureFlags.ControllerMaxRetryDuration()); err != nil {
+		return nil, err
+	}
 
 	deliveryOrderAnnotationValue, ok := trigger.Annotations[deliveryOrderAnnotation]
 	if ok {
@@ -3162,6 +3162,7 @@ func useTableWithFlags(t *testing.T, table TableTest, env *config.Env, flags fea
 				DataPlaneNamespace:           env.SystemNamespace,
 				DispatcherLabel:              base.BrokerDispatcherLabel,
 				ReceiverLabel:                base.BrokerReceiverLabel,
+				ContractBackup:               base.NoopContractBackup{},
 			},
 			FlagsHolder: &FlagsHolder{
 				Flags: ctxFlags,